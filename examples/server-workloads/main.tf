@@ -14,3 +14,16 @@ data "aembit_server_workloads" "first" {}
 output "first_server_workloads" {
   value = data.aembit_server_workloads.first
 }
+
+data "aembit_server_workloads" "production_http" {
+  name_regex   = "^prod-"
+  app_protocol = "HTTP"
+  is_active    = true
+  tags = {
+    env = "production"
+  }
+}
+
+output "production_http_server_workloads" {
+  value = data.aembit_server_workloads.production_http.server_workloads[*].name
+}
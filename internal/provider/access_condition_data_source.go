@@ -6,132 +6,86 @@ import (
 
 	"aembit.io/aembit"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &accessConditionsDataSource{}
-	_ datasource.DataSourceWithConfigure = &accessConditionsDataSource{}
+	_ datasource.DataSource              = &accessConditionDataSource{}
+	_ datasource.DataSourceWithConfigure = &accessConditionDataSource{}
 )
 
-// NewAccessConditionsDataSource is a helper function to simplify the provider implementation.
-func NewAccessConditionsDataSource() datasource.DataSource {
-	return &accessConditionsDataSource{}
+// NewAccessConditionDataSource is a helper function to simplify the provider implementation.
+func NewAccessConditionDataSource() datasource.DataSource {
+	return &accessConditionDataSource{}
 }
 
-// accessConditionsDataSource is the data source implementation.
-type accessConditionsDataSource struct {
-	client *aembit.CloudClient
+// accessConditionDataSource is the data source implementation.
+type accessConditionDataSource struct {
+	client *CloudClient
+	cache  *readCache
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *accessConditionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+func (d *accessConditionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*aembit.CloudClient)
+	data, ok := req.ProviderData.(*aembitProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *aembit.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.cache = data.cache
 }
 
 // Metadata returns the data source type name.
-func (d *accessConditionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_access_conditions"
+func (d *accessConditionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_condition"
 }
 
-// Schema defines the schema for the resource.
-func (d *accessConditionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description: "Manages an accessCondition.",
-		Attributes: map[string]schema.Attribute{
-			"access_conditions": schema.ListNestedAttribute{
-				Description: "List of accessConditions.",
-				Computed:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						// ID field is required for Terraform Framework acceptance testing.
-						"id": schema.StringAttribute{
-							Description: "Unique identifier of the accessCondition.",
-							Computed:    true,
-						},
-						"name": schema.StringAttribute{
-							Description: "User-provided name of the accessCondition.",
-							Computed:    true,
-						},
-						"description": schema.StringAttribute{
-							Description: "User-provided description of the accessCondition.",
-							Computed:    true,
-						},
-						"is_active": schema.BoolAttribute{
-							Description: "Active/Inactive status of the accessCondition.",
-							Computed:    true,
-						},
-						"tags": schema.MapAttribute{
-							Description: "Tags are key-value pairs.",
-							ElementType: types.StringType,
-							Computed:    true,
-						},
-						"integration_id": schema.StringAttribute{
-							Description: "ID of the Integration used by the Access Condition.",
-							Computed:    true,
-						},
-						"wiz_conditions": schema.SingleNestedAttribute{
-							Description: "Wiz Specific rules for the Access Condition.",
-							Computed:    true,
-							Attributes: map[string]schema.Attribute{
-								"max_last_seen":               schema.Int64Attribute{Required: true},
-								"container_cluster_connected": schema.BoolAttribute{Required: true},
-							},
-						},
-						"crowdstrike_conditions": schema.SingleNestedAttribute{
-							Description: "CrowdStrike Specific rules for the Access Condition.",
-							Computed:    true,
-							Attributes: map[string]schema.Attribute{
-								"max_last_seen":       schema.Int64Attribute{Required: true},
-								"match_hostname":      schema.BoolAttribute{Required: true},
-								"match_serial_number": schema.BoolAttribute{Required: true},
-								"prevent_rfm":         schema.BoolAttribute{Required: true},
-							},
-						},
-					},
-				},
-			},
-		},
-	}
+// Schema defines the schema for the data source.
+func (d *accessConditionDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema, resp.Diagnostics = entitySchema(ctx, NewAccessConditionsDataSource(), "access_conditions", "Looks up a single access condition by id or name.", true)
 }
 
 // Read refreshes the Terraform state with the latest data.
-func (d *accessConditionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state accessConditionsDataSourceModel
+func (d *accessConditionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	id, name, diags := getEntityLookup(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	accessConditions, err := d.client.GetAccessConditions(nil)
+	accessConditions, err := cachedList(d.cache, "access_condition", d.client.GetAccessConditions, func(e aembit.AccessConditionDTO) aembit.EntityDTO { return e.EntityDTO })
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to Read Aembit AccessConditions",
+			"Unable to Read Aembit Access Conditions",
 			err.Error(),
 		)
 		return
 	}
 
-	// Map response body to model
-	for _, accessCondition := range accessConditions {
-		accessConditionState := convertAccessConditionDTOToModel(ctx, accessCondition, accessConditionResourceModel{})
-		state.AccessConditions = append(state.AccessConditions, accessConditionState)
+	accessCondition, err := findEntity(accessConditions, id, name, "access condition", func(e aembit.AccessConditionDTO) aembit.EntityDTO { return e.EntityDTO })
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Find Aembit Access Condition",
+			err.Error(),
+		)
+		return
 	}
 
+	// Map response body to model
+	state := convertAccessConditionDTOToModel(ctx, accessCondition, accessConditionResourceModel{})
+
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -32,4 +32,7 @@ type accessConditionCrowdstrikeModel struct {
 // accessConditionDataSourceModel maps the datasource schema.
 type accessConditionsDataSourceModel struct {
 	AccessConditions []accessConditionResourceModel `tfsdk:"access_conditions"`
+	NameRegex        types.String                   `tfsdk:"name_regex"`
+	Tags             types.Map                      `tfsdk:"tags"`
+	IsActive         types.Bool                     `tfsdk:"is_active"`
 }
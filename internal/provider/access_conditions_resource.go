@@ -28,7 +28,9 @@ func NewAccessConditionResource() resource.Resource {
 
 // accessConditionResource is the resource implementation.
 type accessConditionResource struct {
-	client *aembit.CloudClient
+	client *CloudClient
+	cache  *readCache
+	events *eventRecorder
 }
 
 // Metadata returns the resource type name.
@@ -42,18 +44,20 @@ func (r *accessConditionResource) Configure(_ context.Context, req resource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*aembit.CloudClient)
+	data, ok := req.ProviderData.(*aembitProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *aembit.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.cache = data.cache
+	r.events = data.events
 }
 
 // Schema defines the schema for the resource.
@@ -154,6 +158,8 @@ func (r *accessConditionResource) Create(ctx context.Context, req resource.Creat
 	// Generate API request body from plan
 	var dto aembit.AccessConditionDTO = convertAccessConditionModelToDTO(ctx, plan, nil)
 
+	defer r.cache.invalidate("access_condition")
+
 	// Create new AccessCondition
 	accessCondition, err := r.client.CreateAccessCondition(dto, nil)
 	if err != nil {
@@ -173,6 +179,8 @@ func (r *accessConditionResource) Create(ctx context.Context, req resource.Creat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.events.record(ctx, "access_condition", plan.ID.ValueString(), eventActionCreate)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -186,7 +194,7 @@ func (r *accessConditionResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get refreshed trust value from Aembit
-	accessCondition, err := r.client.GetAccessCondition(state.ID.ValueString(), nil)
+	accessCondition, err := cachedGet(r.cache, "access_condition", state.ID.ValueString(), r.client.GetAccessConditions, r.client.GetAccessCondition, func(e aembit.AccessConditionDTO) aembit.EntityDTO { return e.EntityDTO })
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Aembit Access Condition",
@@ -229,6 +237,8 @@ func (r *accessConditionResource) Update(ctx context.Context, req resource.Updat
 	// Generate API request body from plan
 	var dto aembit.AccessConditionDTO = convertAccessConditionModelToDTO(ctx, plan, &externalID)
 
+	defer r.cache.invalidate("access_condition")
+
 	// Update AccessCondition
 	accessCondition, err := r.client.UpdateAccessCondition(dto, nil)
 	if err != nil {
@@ -248,6 +258,8 @@ func (r *accessConditionResource) Update(ctx context.Context, req resource.Updat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.events.record(ctx, "access_condition", state.ID.ValueString(), eventActionUpdate)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -260,6 +272,8 @@ func (r *accessConditionResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	defer r.cache.invalidate("access_condition")
+
 	// Check if Access Condition is Active - if it is, disable it first
 	if state.IsActive == types.BoolValue(true) {
 		_, err := r.client.DisableAccessCondition(state.ID.ValueString(), nil)
@@ -281,6 +295,8 @@ func (r *accessConditionResource) Delete(ctx context.Context, req resource.Delet
 		)
 		return
 	}
+
+	r.events.record(ctx, "access_condition", state.ID.ValueString(), eventActionDelete)
 }
 
 // Imports an existing resource by passing externalId.
@@ -23,7 +23,8 @@ func NewAccessPoliciesDataSource() datasource.DataSource {
 
 // accessPoliciesDataSource is the data source implementation.
 type accessPoliciesDataSource struct {
-	client *aembit.CloudClient
+	client *CloudClient
+	cache  *readCache
 }
 
 // Configure adds the provider configured client to the data source.
@@ -32,17 +33,18 @@ func (d *accessPoliciesDataSource) Configure(_ context.Context, req datasource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*aembit.CloudClient)
+	data, ok := req.ProviderData.(*aembitProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *aembit.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.cache = data.cache
 }
 
 // Metadata returns the data source type name.
@@ -96,13 +98,23 @@ func (d *accessPoliciesDataSource) Schema(_ context.Context, _ datasource.Schema
 			},
 		},
 	}
+
+	resp.Schema.Attributes["is_active"] = schema.BoolAttribute{
+		Description: "Active/Inactive status which the access policies must have.",
+		Optional:    true,
+	}
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *accessPoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state accessPoliciesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	accessPolicies, err := d.client.GetAccessPolicies(nil)
+	accessPolicies, err := cachedList(d.cache, "access_policy", d.client.GetAccessPolicies, func(e aembit.PolicyExternalDTO) aembit.EntityDTO { return e.EntityDTO })
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Aembit Access Policies",
@@ -113,12 +125,15 @@ func (d *accessPoliciesDataSource) Read(ctx context.Context, req datasource.Read
 
 	// Map response body to model
 	for _, accessPolicy := range accessPolicies {
+		if !state.IsActive.IsNull() && state.IsActive.ValueBool() != accessPolicy.EntityDTO.IsActive {
+			continue
+		}
 		accessPolicyState := convertAccessPolicyExternalDTOToModel(accessPolicy)
 		state.AccessPolicies = append(state.AccessPolicies, accessPolicyState)
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
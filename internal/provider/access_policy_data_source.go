@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"aembit.io/aembit"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &accessPolicyDataSource{}
+	_ datasource.DataSourceWithConfigure = &accessPolicyDataSource{}
+)
+
+// NewAccessPolicyDataSource is a helper function to simplify the provider implementation.
+func NewAccessPolicyDataSource() datasource.DataSource {
+	return &accessPolicyDataSource{}
+}
+
+// accessPolicyDataSource is the data source implementation.
+type accessPolicyDataSource struct {
+	client *CloudClient
+	cache  *readCache
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *accessPolicyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*aembitProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+	d.cache = data.cache
+}
+
+// Metadata returns the data source type name.
+func (d *accessPolicyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_policy"
+}
+
+// Schema defines the schema for the data source.
+func (d *accessPolicyDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema, resp.Diagnostics = entitySchema(ctx, NewAccessPoliciesDataSource(), "access_policies", "Looks up a single access policy by id.", false)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *accessPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	id, name, diags := getEntityLookup(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessPolicys, err := cachedList(d.cache, "access_policy", d.client.GetAccessPolicies, func(e aembit.PolicyExternalDTO) aembit.EntityDTO { return e.EntityDTO })
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Aembit Access Policies",
+			err.Error(),
+		)
+		return
+	}
+
+	accessPolicy, err := findEntity(accessPolicys, id, name, "access policy", func(e aembit.PolicyExternalDTO) aembit.EntityDTO { return e.EntityDTO })
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Find Aembit Access Policy",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state := convertAccessPolicyExternalDTOToModel(accessPolicy)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -20,6 +20,7 @@ type accessPolicyResourceModel struct {
 // accessPoliciesDataSourceModel maps the datasource schema.
 type accessPoliciesDataSourceModel struct {
 	AccessPolicies []accessPolicyResourceModel `tfsdk:"access_policies"`
+	IsActive       types.Bool                  `tfsdk:"is_active"`
 }
 
 // policyNoteModel maps the datasource schema.
@@ -28,7 +28,9 @@ func NewAccessPolicyResource() resource.Resource {
 
 // accessPolicyResource is the resource implementation.
 type accessPolicyResource struct {
-	client *aembit.CloudClient
+	client *CloudClient
+	cache  *readCache
+	events *eventRecorder
 }
 
 // Metadata returns the resource type name.
@@ -42,18 +44,20 @@ func (r *accessPolicyResource) Configure(_ context.Context, req resource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*aembit.CloudClient)
+	data, ok := req.ProviderData.(*aembitProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *aembit.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.cache = data.cache
+	r.events = data.events
 }
 
 // Schema defines the schema for the resource.
@@ -124,6 +128,8 @@ func (r *accessPolicyResource) Create(ctx context.Context, req resource.CreateRe
 	// Generate API request body from plan
 	var policy aembit.PolicyDTO = convertAccessPolicyModelToPolicyDTO(plan, nil)
 
+	defer r.cache.invalidate("access_policy")
+
 	// Create new Access Policy
 	accessPolicy, err := r.client.CreateAccessPolicy(policy, nil)
 	if err != nil {
@@ -143,6 +149,8 @@ func (r *accessPolicyResource) Create(ctx context.Context, req resource.CreateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.events.record(ctx, "access_policy", plan.ID.ValueString(), eventActionCreate)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -156,7 +164,7 @@ func (r *accessPolicyResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get refreshed policy value from Aembit
-	accessPolicy, err := r.client.GetAccessPolicy(state.ID.ValueString(), nil)
+	accessPolicy, err := cachedGet(r.cache, "access_policy", state.ID.ValueString(), r.client.GetAccessPolicies, r.client.GetAccessPolicy, func(e aembit.PolicyExternalDTO) aembit.EntityDTO { return e.EntityDTO })
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Aembit Access Policy",
@@ -199,6 +207,8 @@ func (r *accessPolicyResource) Update(ctx context.Context, req resource.UpdateRe
 	// Generate API request body from plan
 	var policy aembit.PolicyDTO = convertAccessPolicyModelToPolicyDTO(plan, &externalID)
 
+	defer r.cache.invalidate("access_policy")
+
 	// Update Access Policy
 	accessPolicy, err := r.client.UpdateAccessPolicy(policy, nil)
 	if err != nil {
@@ -218,6 +228,8 @@ func (r *accessPolicyResource) Update(ctx context.Context, req resource.UpdateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.events.record(ctx, "access_policy", state.ID.ValueString(), eventActionUpdate)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -230,6 +242,8 @@ func (r *accessPolicyResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	defer r.cache.invalidate("access_policy")
+
 	// Check if Access Policy is Active - if it is, disable it first
 	if state.IsActive == types.BoolValue(true) {
 		_, err := r.client.DisableAccessPolicy(state.ID.ValueString(), nil)
@@ -251,6 +265,8 @@ func (r *accessPolicyResource) Delete(ctx context.Context, req resource.DeleteRe
 		)
 		return
 	}
+
+	r.events.record(ctx, "access_policy", state.ID.ValueString(), eventActionDelete)
 }
 
 // Imports an existing resource by passing externalId.
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"aembit.io/aembit"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &agentControllerDataSource{}
+	_ datasource.DataSourceWithConfigure = &agentControllerDataSource{}
+)
+
+// NewAgentControllerDataSource is a helper function to simplify the provider implementation.
+func NewAgentControllerDataSource() datasource.DataSource {
+	return &agentControllerDataSource{}
+}
+
+// agentControllerDataSource is the data source implementation.
+type agentControllerDataSource struct {
+	client *CloudClient
+	cache  *readCache
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *agentControllerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*aembitProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+	d.cache = data.cache
+}
+
+// Metadata returns the data source type name.
+func (d *agentControllerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_controller"
+}
+
+// Schema defines the schema for the data source.
+func (d *agentControllerDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema, resp.Diagnostics = entitySchema(ctx, NewAgentControllersDataSource(), "agent_controllers", "Looks up a single agent controller by id or name.", true)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *agentControllerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	id, name, diags := getEntityLookup(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agentControllers, err := cachedList(d.cache, "agent_controller", d.client.GetAgentControllers, func(e aembit.AgentControllerDTO) aembit.EntityDTO { return e.EntityDTO })
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Aembit Agent Controllers",
+			err.Error(),
+		)
+		return
+	}
+
+	agentController, err := findEntity(agentControllers, id, name, "agent controller", func(e aembit.AgentControllerDTO) aembit.EntityDTO { return e.EntityDTO })
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Find Aembit Agent Controller",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state := convertAgentControllerDTOToDataSourceModel(ctx, agentController)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
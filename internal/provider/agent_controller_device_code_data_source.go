@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"aembit.io/aembit"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,7 +22,7 @@ func NewAgentControllerDeviceCodeDataSource() datasource.DataSource {
 
 // agentControllerDeviceCodeDataSource is the data source implementation.
 type agentControllerDeviceCodeDataSource struct {
-	client *aembit.CloudClient
+	client *CloudClient
 }
 
 // Configure adds the provider configured client to the data source.
@@ -32,17 +31,17 @@ func (d *agentControllerDeviceCodeDataSource) Configure(_ context.Context, req d
 		return
 	}
 
-	client, ok := req.ProviderData.(*aembit.CloudClient)
+	data, ok := req.ProviderData.(*aembitProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *aembit.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = data.client
 }
 
 // Metadata returns the data source type name.
@@ -53,7 +52,7 @@ func (d *agentControllerDeviceCodeDataSource) Metadata(_ context.Context, req da
 // Schema defines the schema for the resource.
 func (d *agentControllerDeviceCodeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Generates an agent controller device code.",
+		Description: "Generates an agent controller device code on every read. Use the aembit_agent_controller_device_code resource to keep a device code until it expires.",
 		Attributes: map[string]schema.Attribute{
 			"agent_controller_id": schema.StringAttribute{
 				Description: "Unique identifier of the Agent Controller.",
@@ -9,3 +9,12 @@ type agentControllerDeviceCodeDataSourceModel struct {
 	ID         types.String `tfsdk:"agent_controller_id"`
 	DeviceCode types.String `tfsdk:"device_code"`
 }
+
+// agentControllerDeviceCodeResourceModel maps the resource schema.
+type agentControllerDeviceCodeResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	AgentControllerID types.String `tfsdk:"agent_controller_id"`
+	DeviceCode        types.String `tfsdk:"device_code"`
+	ExpiresAt         types.String `tfsdk:"expires_at"`
+	RotationTrigger   types.Map    `tfsdk:"rotation_trigger"`
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// agentControllerDeviceCodeLifetime is how long Aembit accepts a generated device code.
+const agentControllerDeviceCodeLifetime = 15 * time.Minute
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &agentControllerDeviceCodeResource{}
+	_ resource.ResourceWithConfigure  = &agentControllerDeviceCodeResource{}
+	_ resource.ResourceWithModifyPlan = &agentControllerDeviceCodeResource{}
+)
+
+// NewAgentControllerDeviceCodeResource is a helper function to simplify the provider implementation.
+func NewAgentControllerDeviceCodeResource() resource.Resource {
+	return &agentControllerDeviceCodeResource{}
+}
+
+// agentControllerDeviceCodeResource is the resource implementation.
+type agentControllerDeviceCodeResource struct {
+	client *CloudClient
+}
+
+// Metadata returns the resource type name.
+func (r *agentControllerDeviceCodeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_controller_device_code"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *agentControllerDeviceCodeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*aembitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+// Schema defines the schema for the resource.
+func (r *agentControllerDeviceCodeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates an agent controller device code and keeps it in the Terraform state. " +
+			"A new device code is generated only when the current one has expired, or the `agent_controller_id` or `rotation_trigger` changes.",
+		Attributes: map[string]schema.Attribute{
+			// ID field is required for Terraform Framework acceptance testing.
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the Agent Controller the device code was generated for.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"agent_controller_id": schema.StringAttribute{
+				Description: "Unique identifier of the Agent Controller.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"device_code": schema.StringAttribute{
+				Description: "Generated Device Code of the Agent Controller.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Time at which the device code expires, in RFC3339 format. Device codes are valid for 15 minutes.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotation_trigger": schema.MapAttribute{
+				Description: "Arbitrary map of values which, when changed, generates a new device code. " +
+					"Change it to register a new agent controller host before the device code has expired.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan generates a new device code when the current one has expired.
+func (r *agentControllerDeviceCodeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to expire when creating or destroying the device code
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state agentControllerDeviceCodeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !isDeviceCodeExpired(state.ExpiresAt.ValueString(), time.Now()) {
+		return
+	}
+
+	for _, attribute := range []string{"device_code", "expires_at"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attribute), types.StringUnknown())...)
+	}
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+}
+
+// Create generates a new device code and sets the initial Terraform state.
+func (r *agentControllerDeviceCodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan agentControllerDeviceCodeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate the device code
+	issuedAt := time.Now()
+	deviceCode, err := r.client.GetAgentControllerDeviceCode(plan.AgentControllerID.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Agent Controller Device Code",
+			"Could not generate Agent Controller Device Code, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = plan.AgentControllerID
+	plan.DeviceCode = types.StringValue(deviceCode.DeviceCode)
+	plan.ExpiresAt = types.StringValue(issuedAt.Add(agentControllerDeviceCodeLifetime).UTC().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state. The device code is only held in state, so there is nothing to refresh.
+func (r *agentControllerDeviceCodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state agentControllerDeviceCodeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is not expected to be called, since every configurable attribute replaces the device code.
+func (r *agentControllerDeviceCodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan agentControllerDeviceCodeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the device code from the Terraform state. Device codes expire and are not revoked.
+func (r *agentControllerDeviceCodeResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// isDeviceCodeExpired returns true if the device code has expired, or its expiry time cannot be parsed.
+func isDeviceCodeExpired(expiresAt string, now time.Time) bool {
+	expiry, err := time.Parse(time.RFC3339, expiresAt)
+	return err != nil || !now.Before(expiry)
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestIsDeviceCodeExpired(t *testing.T) {
+	now := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		expiresAt string
+		want      bool
+	}{
+		{expiresAt: "2030-01-01T12:15:00Z", want: false},
+		{expiresAt: "2030-01-01T12:00:00Z", want: true},
+		{expiresAt: "2030-01-01T11:45:00Z", want: true},
+		{expiresAt: "", want: true},
+	}
+	for _, c := range cases {
+		if got := isDeviceCodeExpired(c.expiresAt, now); got != c.want {
+			t.Errorf("isDeviceCodeExpired(%q) = %v, want %v", c.expiresAt, got, c.want)
+		}
+	}
+}
+
+func TestAgentControllerDeviceCodeModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := &agentControllerDeviceCodeResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	schemaType := schemaResp.Schema.Type().TerraformType(ctx)
+	newValue := func(expiresAt string) tftypes.Value {
+		return tftypes.NewValue(schemaType, map[string]tftypes.Value{
+			"id":                  tftypes.NewValue(tftypes.String, "a1b2"),
+			"agent_controller_id": tftypes.NewValue(tftypes.String, "a1b2"),
+			"device_code":         tftypes.NewValue(tftypes.String, "123456"),
+			"expires_at":          tftypes.NewValue(tftypes.String, expiresAt),
+			"rotation_trigger":    tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		})
+	}
+
+	cases := map[string]struct {
+		expiresAt string
+		replace   bool
+	}{
+		"expired":   {time.Now().Add(-time.Minute).UTC().Format(time.RFC3339), true},
+		"unexpired": {time.Now().Add(10 * time.Minute).UTC().Format(time.RFC3339), false},
+	}
+	for name, c := range cases {
+		req := resource.ModifyPlanRequest{
+			State: tfsdk.State{Schema: schemaResp.Schema, Raw: newValue(c.expiresAt)},
+			Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: newValue(c.expiresAt)},
+		}
+		resp := resource.ModifyPlanResponse{Plan: req.Plan}
+		r.ModifyPlan(ctx, req, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected error: %v", name, resp.Diagnostics)
+		}
+
+		var plan agentControllerDeviceCodeResourceModel
+		resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+		if replace := len(resp.RequiresReplace) > 0; replace != c.replace {
+			t.Errorf("%s: RequiresReplace = %v, want replacement %v", name, resp.RequiresReplace, c.replace)
+		}
+		if c.replace && (!resp.RequiresReplace.Contains(path.Root("expires_at")) || !plan.DeviceCode.IsUnknown() || !plan.ExpiresAt.IsUnknown()) {
+			t.Errorf("%s: expected a new device code and expiry to be planned, got %+v", name, plan)
+		}
+		if !c.replace && plan.DeviceCode.ValueString() != "123456" {
+			t.Errorf("%s: expected the device code to be kept, got %+v", name, plan)
+		}
+	}
+}
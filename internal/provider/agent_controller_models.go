@@ -4,8 +4,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// agentControllerResourceModel maps the resource schema.
+// agentControllerResourceModel maps the resource schema, which has the attributes of the data sources along with the
+// registration wait.
 type agentControllerResourceModel struct {
+	agentControllerDataSourceModel
+	WaitForRegistration types.Bool   `tfsdk:"wait_for_registration"`
+	RegistrationTimeout types.String `tfsdk:"registration_timeout"`
+}
+
+// agentControllerDataSourceModel maps the datasource schema.
+type agentControllersDataSourceModel struct {
+	AgentControllers []agentControllerDataSourceModel `tfsdk:"agent_controllers"`
+	NameRegex        types.String                     `tfsdk:"name_regex"`
+	Tags             types.Map                        `tfsdk:"tags"`
+	IsActive         types.Bool                       `tfsdk:"is_active"`
+}
+
+// agentControllerDataSourceModel maps an agent controller of the data sources.
+type agentControllerDataSourceModel struct {
 	// ID is required for Framework acceptance testing
 	ID              types.String `tfsdk:"id"`
 	Name            types.String `tfsdk:"name"`
@@ -13,9 +29,8 @@ type agentControllerResourceModel struct {
 	IsActive        types.Bool   `tfsdk:"is_active"`
 	Tags            types.Map    `tfsdk:"tags"`
 	TrustProviderID types.String `tfsdk:"trust_provider_id"`
-}
-
-// agentControllerDataSourceModel maps the datasource schema.
-type agentControllersDataSourceModel struct {
-	AgentControllers []agentControllerResourceModel `tfsdk:"agent_controllers"`
+	Registered      types.Bool   `tfsdk:"registered"`
+	LastSeen        types.String `tfsdk:"last_seen"`
+	Version         types.String `tfsdk:"version"`
+	TLSHostname     types.String `tfsdk:"tls_hostname"`
 }
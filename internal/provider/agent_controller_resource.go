@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"aembit.io/aembit"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -13,6 +15,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// agentControllerRegistrationTimeout and agentControllerRegistrationInterval control waiting for an agent controller to register.
+const (
+	agentControllerRegistrationTimeout  = 10 * time.Minute
+	agentControllerRegistrationInterval = 10 * time.Second
+)
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                = &agentControllerResource{}
@@ -27,7 +35,9 @@ func NewAgentControllerResource() resource.Resource {
 
 // agentControllerResource is the resource implementation.
 type agentControllerResource struct {
-	client *aembit.CloudClient
+	client *CloudClient
+	cache  *readCache
+	events *eventRecorder
 }
 
 // Metadata returns the resource type name.
@@ -41,18 +51,20 @@ func (r *agentControllerResource) Configure(_ context.Context, req resource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*aembit.CloudClient)
+	data, ok := req.ProviderData.(*aembitProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *aembit.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.cache = data.cache
+	r.events = data.events
 }
 
 // Schema defines the schema for the resource.
@@ -90,6 +102,34 @@ func (r *agentControllerResource) Schema(_ context.Context, _ resource.SchemaReq
 				Description: "Unique Trust Provider to use for authentication of the Agent Controller.",
 				Optional:    true,
 			},
+			"registered": schema.BoolAttribute{
+				Description: "Indicates that the Agent Controller has registered with Aembit Cloud and reports healthy.",
+				Computed:    true,
+			},
+			"last_seen": schema.StringAttribute{
+				Description: "Time at which the Agent Controller last reported its health.",
+				Computed:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "Version of the registered Agent Controller.",
+				Computed:    true,
+			},
+			"tls_hostname": schema.StringAttribute{
+				Description: "Hostname of the TLS certificate issued to the Agent Controller.",
+				Computed:    true,
+			},
+			"wait_for_registration": schema.BoolAttribute{
+				Description: "Wait, when the Agent Controller is created or updated, until it has registered and reports healthy. " +
+					"The host which registers the Agent Controller must not depend on this resource, or it is only created once the wait has failed.",
+				Optional: true,
+			},
+			"registration_timeout": schema.StringAttribute{
+				Description: "Maximum time to wait for the Agent Controller to register, e.g. `15m`. Defaults to `10m`.",
+				Optional:    true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
 		},
 	}
 }
@@ -107,6 +147,8 @@ func (r *agentControllerResource) Create(ctx context.Context, req resource.Creat
 	// Generate API request body from plan
 	var controller aembit.AgentControllerDTO = convertAgentControllerModelToDTO(ctx, plan, nil)
 
+	defer r.cache.invalidate("agent_controller")
+
 	// Create new Agent Controller
 	agentController, err := r.client.CreateAgentController(controller, nil)
 	if err != nil {
@@ -118,7 +160,7 @@ func (r *agentControllerResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	plan = convertAgentControllerDTOToModel(ctx, *agentController)
+	plan = convertAgentControllerDTOToModel(ctx, *agentController, plan)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -126,6 +168,12 @@ func (r *agentControllerResource) Create(ctx context.Context, req resource.Creat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.events.record(ctx, "agent_controller", plan.ID.ValueString(), eventActionCreate)
+
+	// Wait for the Agent Controller to register, if requested
+	plan = r.waitForRegistration(ctx, plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -139,7 +187,7 @@ func (r *agentControllerResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get refreshed controller value from Aembit
-	agentController, err := r.client.GetAgentController(state.ID.ValueString(), nil)
+	agentController, err := cachedGet(r.cache, "agent_controller", state.ID.ValueString(), r.client.GetAgentControllers, r.client.GetAgentController, func(e aembit.AgentControllerDTO) aembit.EntityDTO { return e.EntityDTO })
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Aembit Agent Controller",
@@ -148,7 +196,7 @@ func (r *agentControllerResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	state = convertAgentControllerDTOToModel(ctx, agentController)
+	state = convertAgentControllerDTOToModel(ctx, agentController, state)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -182,6 +230,8 @@ func (r *agentControllerResource) Update(ctx context.Context, req resource.Updat
 	// Generate API request body from plan
 	var controller aembit.AgentControllerDTO = convertAgentControllerModelToDTO(ctx, plan, &externalID)
 
+	defer r.cache.invalidate("agent_controller")
+
 	// Update Agent Controller
 	agentController, err := r.client.UpdateAgentController(controller, nil)
 	if err != nil {
@@ -193,7 +243,7 @@ func (r *agentControllerResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	state = convertAgentControllerDTOToModel(ctx, *agentController)
+	state = convertAgentControllerDTOToModel(ctx, *agentController, plan)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, state)
@@ -201,6 +251,12 @@ func (r *agentControllerResource) Update(ctx context.Context, req resource.Updat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.events.record(ctx, "agent_controller", state.ID.ValueString(), eventActionUpdate)
+
+	// Wait for the Agent Controller to register, if requested
+	state = r.waitForRegistration(ctx, state, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -213,6 +269,8 @@ func (r *agentControllerResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	defer r.cache.invalidate("agent_controller")
+
 	// Check if Agent Controller is Active - if it is, disable it first
 	if state.IsActive == types.BoolValue(true) {
 		_, err := r.client.DisableAgentController(state.ID.ValueString(), nil)
@@ -234,6 +292,8 @@ func (r *agentControllerResource) Delete(ctx context.Context, req resource.Delet
 		)
 		return
 	}
+
+	r.events.record(ctx, "agent_controller", state.ID.ValueString(), eventActionDelete)
 }
 
 // Imports an existing resource by passing externalId.
@@ -242,6 +302,34 @@ func (r *agentControllerResource) ImportState(ctx context.Context, req resource.
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// waitForRegistration waits for the agent controller to register if wait_for_registration is set, and returns the
+// model with the registered agent controller. A failed wait is added to the diagnostics.
+func (r *agentControllerResource) waitForRegistration(ctx context.Context, model agentControllerResourceModel, diags *diag.Diagnostics) agentControllerResourceModel {
+	if !model.WaitForRegistration.ValueBool() || model.Registered.ValueBool() {
+		return model
+	}
+
+	// The timeout is validated with the configuration
+	timeout := agentControllerRegistrationTimeout
+	if len(model.RegistrationTimeout.ValueString()) > 0 {
+		timeout, _ = time.ParseDuration(model.RegistrationTimeout.ValueString())
+	}
+
+	defer r.cache.invalidate("agent_controller")
+
+	agentController, err := waitForAgentControllerRegistration(ctx, model.ID.ValueString(), timeout, agentControllerRegistrationInterval, func(id string) (aembit.AgentControllerDTO, error) {
+		return r.client.GetAgentController(id, nil)
+	})
+	if err != nil {
+		diags.AddError(
+			"Aembit Agent Controller Not Registered",
+			err.Error(),
+		)
+		return model
+	}
+	return convertAgentControllerDTOToModel(ctx, agentController, model)
+}
+
 func convertAgentControllerModelToDTO(ctx context.Context, model agentControllerResourceModel, externalID *string) aembit.AgentControllerDTO {
 	var controller aembit.AgentControllerDTO
 	controller.EntityDTO = aembit.EntityDTO{
@@ -268,8 +356,16 @@ func convertAgentControllerModelToDTO(ctx context.Context, model agentController
 	return controller
 }
 
-func convertAgentControllerDTOToModel(ctx context.Context, dto aembit.AgentControllerDTO) agentControllerResourceModel {
-	var model agentControllerResourceModel
+// convertAgentControllerDTOToModel converts an agent controller for the resource, keeping the registration wait
+// settings of the model.
+func convertAgentControllerDTOToModel(ctx context.Context, dto aembit.AgentControllerDTO, model agentControllerResourceModel) agentControllerResourceModel {
+	model.agentControllerDataSourceModel = convertAgentControllerDTOToDataSourceModel(ctx, dto)
+	return model
+}
+
+// convertAgentControllerDTOToDataSourceModel converts an agent controller for the data sources.
+func convertAgentControllerDTOToDataSourceModel(ctx context.Context, dto aembit.AgentControllerDTO) agentControllerDataSourceModel {
+	var model agentControllerDataSourceModel
 	model.ID = types.StringValue(dto.EntityDTO.ExternalID)
 	model.Name = types.StringValue(dto.EntityDTO.Name)
 	model.Description = types.StringValue(dto.EntityDTO.Description)
@@ -280,6 +376,35 @@ func convertAgentControllerDTOToModel(ctx context.Context, dto aembit.AgentContr
 		model.TrustProviderID = types.StringNull()
 	}
 	model.Tags = newTagsModel(ctx, dto.EntityDTO.Tags)
+	model.Registered = types.BoolValue(dto.IsHealthy)
+	model.LastSeen = types.StringValue(dto.LastReportedHealthTime)
+	model.Version = types.StringValue(dto.Version)
+	model.TLSHostname = types.StringValue(dto.AllowedTLSHostname)
 
 	return model
 }
+
+// waitForAgentControllerRegistration polls the agent controller until it reports healthy, or the timeout expires.
+func waitForAgentControllerRegistration(ctx context.Context, id string, timeout, interval time.Duration, get func(id string) (aembit.AgentControllerDTO, error)) (aembit.AgentControllerDTO, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		agentController, err := get(id)
+		if err != nil {
+			return agentController, err
+		}
+		if agentController.IsHealthy {
+			return agentController, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return agentController, fmt.Errorf("agent controller %s did not register within %s", id, timeout)
+		case <-ticker.C:
+		}
+	}
+}
@@ -1,10 +1,14 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"os"
 	"regexp"
 	"testing"
+	"time"
 
+	"aembit.io/aembit"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -71,3 +75,31 @@ func TestAccAgentControllerResource_Validation(t *testing.T) {
 		},
 	})
 }
+
+func TestWaitForAgentControllerRegistration(t *testing.T) {
+	ctx := context.Background()
+
+	polls := 0
+	registersOnThirdPoll := func(id string) (aembit.AgentControllerDTO, error) {
+		polls++
+		return aembit.AgentControllerDTO{EntityDTO: aembit.EntityDTO{ExternalID: id}, IsHealthy: polls == 3}, nil
+	}
+	agentController, err := waitForAgentControllerRegistration(ctx, "controller", time.Second, time.Millisecond, registersOnThirdPoll)
+	if err != nil || !agentController.IsHealthy || polls != 3 {
+		t.Errorf("expected registration on the third poll, got %v after %d polls (%v)", agentController, polls, err)
+	}
+
+	neverRegisters := func(id string) (aembit.AgentControllerDTO, error) {
+		return aembit.AgentControllerDTO{EntityDTO: aembit.EntityDTO{ExternalID: id}}, nil
+	}
+	if _, err = waitForAgentControllerRegistration(ctx, "controller", 5*time.Millisecond, time.Millisecond, neverRegisters); err == nil {
+		t.Error("expected a timeout error")
+	}
+
+	failing := func(_ string) (aembit.AgentControllerDTO, error) {
+		return aembit.AgentControllerDTO{}, errors.New("unavailable")
+	}
+	if _, err = waitForAgentControllerRegistration(ctx, "controller", time.Second, time.Millisecond, failing); err == nil {
+		t.Error("expected the API error")
+	}
+}
@@ -23,7 +23,8 @@ func NewAgentControllersDataSource() datasource.DataSource {
 
 // agentControllersDataSource is the data source implementation.
 type agentControllersDataSource struct {
-	client *aembit.CloudClient
+	client *CloudClient
+	cache  *readCache
 }
 
 // Configure adds the provider configured client to the data source.
@@ -32,17 +33,18 @@ func (d *agentControllersDataSource) Configure(_ context.Context, req datasource
 		return
 	}
 
-	client, ok := req.ProviderData.(*aembit.CloudClient)
+	data, ok := req.ProviderData.(*aembitProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *aembit.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.cache = data.cache
 }
 
 // Metadata returns the data source type name.
@@ -86,18 +88,47 @@ func (d *agentControllersDataSource) Schema(_ context.Context, _ datasource.Sche
 							Description: "Trust Provider to use for authentication of the agent controller.",
 							Computed:    true,
 						},
+						"registered": schema.BoolAttribute{
+							Description: "Indicates that the agent controller has registered and reports healthy.",
+							Computed:    true,
+						},
+						"last_seen": schema.StringAttribute{
+							Description: "Time the agent controller last reported its health.",
+							Computed:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "Version of the registered agent controller.",
+							Computed:    true,
+						},
+						"tls_hostname": schema.StringAttribute{
+							Description: "Hostname of the TLS certificate issued to the agent controller.",
+							Computed:    true,
+						},
 					},
 				},
 			},
 		},
 	}
+
+	addEntityFilterAttributes(resp.Schema.Attributes, "agent controllers")
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *agentControllersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state agentControllersDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newEntityFilter(ctx, state.NameRegex, state.Tags, state.IsActive)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	agentControllers, err := d.client.GetAgentControllers(nil)
+	agentControllers, err := cachedList(d.cache, "agent_controller", d.client.GetAgentControllers, func(e aembit.AgentControllerDTO) aembit.EntityDTO { return e.EntityDTO })
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Aembit Agent Controllers",
@@ -108,12 +139,15 @@ func (d *agentControllersDataSource) Read(ctx context.Context, req datasource.Re
 
 	// Map response body to model
 	for _, agentController := range agentControllers {
-		agentControllerState := convertAgentControllerDTOToModel(ctx, agentController)
+		if !filter.matches(agentController.EntityDTO) {
+			continue
+		}
+		agentControllerState := convertAgentControllerDTOToDataSourceModel(ctx, agentController)
 		state.AgentControllers = append(state.AgentControllers, agentControllerState)
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// automationIdentityResourceModel maps the resource schema.
+type automationIdentityResourceModel struct {
+	// ID is required for Framework acceptance testing
+	ID                   types.String                    `tfsdk:"id"`
+	Name                 types.String                    `tfsdk:"name"`
+	RoleID               types.String                    `tfsdk:"role_id"`
+	Lifetime             int32                           `tfsdk:"lifetime"`
+	GitHubAction         *trustProviderGitHubActionModel `tfsdk:"github_action"`
+	TerraformWorkspace   *trustProviderTerraformModel    `tfsdk:"terraform_workspace"`
+	ClientID             types.String                    `tfsdk:"client_id"`
+	TrustProviderID      types.String                    `tfsdk:"trust_provider_id"`
+	ClientWorkloadID     types.String                    `tfsdk:"client_workload_id"`
+	ServerWorkloadID     types.String                    `tfsdk:"server_workload_id"`
+	CredentialProviderID types.String                    `tfsdk:"credential_provider_id"`
+	AccessPolicyID       types.String                    `tfsdk:"access_policy_id"`
+}
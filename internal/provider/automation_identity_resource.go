@@ -0,0 +1,540 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"aembit.io/aembit"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &automationIdentityResource{}
+	_ resource.ResourceWithConfigure        = &automationIdentityResource{}
+	_ resource.ResourceWithConfigValidators = &automationIdentityResource{}
+	_ resource.ResourceWithModifyPlan       = &automationIdentityResource{}
+)
+
+// NewAutomationIdentityResource is a helper function to simplify the provider implementation.
+func NewAutomationIdentityResource() resource.Resource {
+	return &automationIdentityResource{}
+}
+
+// automationIdentityResource is the resource implementation.
+type automationIdentityResource struct {
+	client *CloudClient
+	cache  *readCache
+	events *eventRecorder
+}
+
+// automationIdentityEntity is an Aembit entity created by the automation identity, with the calls to tear it down.
+type automationIdentityEntity struct {
+	entityType string
+	id         *types.String
+	disable    func(id string, h http.Header) error
+	delete     func(id string, h http.Header) (bool, error)
+}
+
+// Metadata returns the resource type name.
+func (r *automationIdentityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automation_identity"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *automationIdentityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*aembitProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.cache = data.cache
+	r.events = data.events
+}
+
+// Schema defines the schema for the resource.
+func (r *automationIdentityResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates the Trust Provider, Client Workload, Aembit API Server Workload, Aembit Access Token Credential Provider and " +
+			"Access Policy with which Terraform authenticates to Aembit using the provider `client_id`. " +
+			"Changing any argument replaces all of these entities, and destroying the resource deletes them in dependency order.\n\n" +
+			"**Note:** One and only one of `github_action` or `terraform_workspace` must be provided.",
+		Attributes: map[string]schema.Attribute{
+			// ID field is required for Terraform Framework acceptance testing.
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the Automation Identity, also used as the identifier of its Client ID.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name for the created Aembit entities.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Description: "Aembit Role ID of the Aembit Access Token Credential Provider.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"lifetime": schema.Int64Attribute{
+				Description: "Lifetime of the Aembit Access Token Credential Provider.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"github_action": automationIdentityTrustProviderAttribute(ctx, "github_action",
+				"GitHub Action configuration of the created Trust Provider. Requires `repository` or `repositories`.",
+				"repository", "repositories"),
+			"terraform_workspace": automationIdentityTrustProviderAttribute(ctx, "terraform_workspace",
+				"Terraform Workspace configuration of the created Trust Provider. Requires an organization or workspace ID, or an organization name.",
+				"organization_id", "organization_ids", "organization_name", "organization_names", "workspace_id", "workspace_ids"),
+			"client_id": schema.StringAttribute{
+				Description: "Aembit Client ID to configure as the provider `client_id`.",
+				Computed:    true,
+			},
+			"trust_provider_id": schema.StringAttribute{
+				Description: "Unique identifier of the created Trust Provider.",
+				Computed:    true,
+			},
+			"client_workload_id": schema.StringAttribute{
+				Description: "Unique identifier of the created Client Workload.",
+				Computed:    true,
+			},
+			"server_workload_id": schema.StringAttribute{
+				Description: "Unique identifier of the created Aembit API Server Workload.",
+				Computed:    true,
+			},
+			"credential_provider_id": schema.StringAttribute{
+				Description: "Unique identifier of the created Aembit Access Token Credential Provider.",
+				Computed:    true,
+			},
+			"access_policy_id": schema.StringAttribute{
+				Description: "Unique identifier of the created Access Policy.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// automationIdentityTrustProviderAttribute returns the aembit_trust_provider configuration of the given type, which
+// replaces the automation identity when changed. At least one of the required match attributes must be configured,
+// so that the Trust Provider cannot attest any workload of its type; the first of them must be a string attribute.
+func automationIdentityTrustProviderAttribute(ctx context.Context, name, description string, required ...string) schema.SingleNestedAttribute {
+	var trustProviderSchema resource.SchemaResponse
+	NewTrustProviderResource().Schema(ctx, resource.SchemaRequest{}, &trustProviderSchema)
+
+	attribute, _ := trustProviderSchema.Schema.Attributes[name].(schema.SingleNestedAttribute)
+	attribute.Description = description
+	attribute.PlanModifiers = []planmodifier.Object{
+		objectplanmodifier.RequiresReplace(),
+	}
+
+	others := make([]path.Expression, 0, len(required)-1)
+	for _, other := range required[1:] {
+		others = append(others, path.MatchRelative().AtParent().AtName(other))
+	}
+	first, _ := attribute.Attributes[required[0]].(schema.StringAttribute)
+	first.Validators = append(first.Validators, stringvalidator.AtLeastOneOf(others...))
+	attribute.Attributes[required[0]] = first
+	return attribute
+}
+
+// ConfigValidators validates that exactly one Trust Provider type is configured.
+func (r *automationIdentityResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("github_action"),
+			path.MatchRoot("terraform_workspace"),
+		),
+	}
+}
+
+// Create creates the Aembit entities in dependency order and sets the initial Terraform state.
+func (r *automationIdentityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan automationIdentityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	trustProviderModel := trustProviderResourceModel{
+		Name:               plan.Name,
+		Description:        types.StringValue(""),
+		IsActive:           types.BoolValue(true),
+		Tags:               types.MapNull(types.StringType),
+		GitHubAction:       plan.GitHubAction,
+		TerraformWorkspace: plan.TerraformWorkspace,
+	}
+	trust, err := convertTrustProviderModelToDTO(ctx, trustProviderModel, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating automation identity",
+			"Could not convert the trust provider configuration, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(uuid.NewString())
+	plan.ClientID = types.StringValue(newAembitClientID(r.client.StackDomain, r.client.Tenant, clientIDIdentityTypes[trust.Provider], plan.ID.ValueString()))
+	plan.TrustProviderID = types.StringNull()
+	plan.ClientWorkloadID = types.StringNull()
+	plan.ServerWorkloadID = types.StringNull()
+	plan.CredentialProviderID = types.StringNull()
+	plan.AccessPolicyID = types.StringNull()
+
+	// Always save the entities created so far: if one of them fails, Terraform taints the resource and tears down the others.
+	defer func() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	}()
+	defer r.invalidateCache()
+
+	// Create the Trust Provider
+	trustProvider, err := r.client.CreateTrustProvider(trust, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating automation identity",
+			"Could not create trust provider, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.TrustProviderID = r.recordCreated(ctx, "trust_provider", trustProvider.EntityDTO.ExternalID)
+	trustProviderModel = convertTrustProviderDTOToModel(ctx, *trustProvider, trustProviderModel)
+	plan.GitHubAction = trustProviderModel.GitHubAction
+	plan.TerraformWorkspace = trustProviderModel.TerraformWorkspace
+
+	// Create the Client Workload identified by the Client ID
+	clientWorkload, err := r.client.CreateClientWorkload(convertClientWorkloadModelToDTO(ctx, newAutomationIdentityClientWorkloadModel(ctx, plan), nil), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating automation identity",
+			"Could not create client workload, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.ClientWorkloadID = r.recordCreated(ctx, "client_workload", clientWorkload.EntityDTO.ExternalID)
+
+	// Create the Server Workload of the Aembit API
+	serverWorkload, err := r.client.CreateServerWorkload(convertServerWorkloadModelToDTO(ctx, newAutomationIdentityServerWorkloadModel(plan, r.client.Tenant, r.client.StackDomain), nil), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating automation identity",
+			"Could not create server workload, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.ServerWorkloadID = r.recordCreated(ctx, "server_workload", serverWorkload.EntityDTO.ExternalID)
+
+	// Create the Aembit Access Token Credential Provider
+	credentialProviderModel := credentialProviderResourceModel{
+		Name:        plan.Name,
+		Description: types.StringValue(""),
+		IsActive:    types.BoolValue(true),
+		Tags:        types.MapNull(types.StringType),
+		AembitToken: &credentialProviderAembitTokenModel{Role: plan.RoleID, Lifetime: plan.Lifetime},
+	}
+	credentialProvider, err := r.client.CreateCredentialProvider(convertCredentialProviderModelToDTO(ctx, credentialProviderModel, nil, r.client.Tenant, r.client.StackDomain), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating automation identity",
+			"Could not create credential provider, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.CredentialProviderID = r.recordCreated(ctx, "credential_provider", credentialProvider.EntityDTO.ExternalID)
+
+	// Create the Access Policy linking all of the above
+	accessPolicy, err := r.client.CreateAccessPolicy(convertAccessPolicyModelToPolicyDTO(newAutomationIdentityAccessPolicyModel(plan), nil), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating automation identity",
+			"Could not create access policy, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.AccessPolicyID = r.recordCreated(ctx, "access_policy", accessPolicy.EntityDTO.ExternalID)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *automationIdentityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state automationIdentityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Refresh the Trust Provider configuration, so that changes made outside of Terraform replace the automation identity
+	if !state.TrustProviderID.IsNull() {
+		trustProvider, found, err := cachedFind(r.cache, "trust_provider", state.TrustProviderID.ValueString(), r.client.GetTrustProviders, func(e aembit.TrustProviderDTO) aembit.EntityDTO { return e.EntityDTO })
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Aembit Automation Identity",
+				"Could not read Aembit Trust Provider "+state.TrustProviderID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		if found {
+			trustProviderModel := convertTrustProviderDTOToModel(ctx, trustProvider, trustProviderResourceModel{GitHubAction: state.GitHubAction, TerraformWorkspace: state.TerraformWorkspace})
+			state.GitHubAction = trustProviderModel.GitHubAction
+			state.TerraformWorkspace = trustProviderModel.TerraformWorkspace
+		} else {
+			state.TrustProviderID = types.StringNull()
+		}
+	}
+
+	// Verify the other entities still exist. The ID of an entity deleted outside of Terraform is removed from the state,
+	// so that ModifyPlan replaces the automation identity.
+	existenceChecks := map[string]func(id string) (bool, error){
+		"client_workload": func(id string) (bool, error) {
+			_, found, err := cachedFind(r.cache, "client_workload", id, r.client.GetClientWorkloads, func(e aembit.ClientWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO })
+			return found, err
+		},
+		"server_workload": func(id string) (bool, error) {
+			_, found, err := cachedFind(r.cache, "server_workload", id, r.client.GetServerWorkloads, func(e aembit.ServerWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO })
+			return found, err
+		},
+		"credential_provider": func(id string) (bool, error) {
+			_, found, err := cachedFind(r.cache, "credential_provider", id, r.client.GetCredentialProviders, func(e aembit.CredentialProviderDTO) aembit.EntityDTO { return e.EntityDTO })
+			return found, err
+		},
+		"access_policy": func(id string) (bool, error) {
+			_, found, err := cachedFind(r.cache, "access_policy", id, r.client.GetAccessPolicies, func(e aembit.PolicyExternalDTO) aembit.EntityDTO { return e.EntityDTO })
+			return found, err
+		},
+	}
+	for _, entity := range r.teardownOrder(&state) {
+		check, ok := existenceChecks[entity.entityType]
+		if !ok || entity.id.IsNull() {
+			continue
+		}
+		found, err := check(entity.id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Aembit Automation Identity",
+				fmt.Sprintf("Could not read Aembit %s %s: %s", strings.ReplaceAll(entity.entityType, "_", " "), entity.id.ValueString(), err.Error()),
+			)
+			return
+		}
+		if !found {
+			*entity.id = types.StringNull()
+		}
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ModifyPlan replaces the automation identity if any of its entities was deleted outside of Terraform, as detected by Read.
+func (r *automationIdentityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to replace on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state automationIdentityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, entity := range r.teardownOrder(&state) {
+		if !entity.id.IsNull() {
+			continue
+		}
+		attribute := path.Root(entity.entityType + "_id")
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, attribute, types.StringUnknown())...)
+		resp.RequiresReplace = append(resp.RequiresReplace, attribute)
+	}
+}
+
+// Update keeps the created entities, as every change of the configuration replaces the automation identity.
+func (r *automationIdentityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get current state
+	var state automationIdentityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the Aembit entities in dependency order and removes the Terraform state on success.
+func (r *automationIdentityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state automationIdentityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer r.invalidateCache()
+
+	for _, entity := range r.teardownOrder(&state) {
+		if entity.id.IsNull() || len(entity.id.ValueString()) == 0 {
+			continue
+		}
+
+		// Disable the entity first, as only inactive entities can be deleted
+		err := entity.disable(entity.id.ValueString(), nil)
+		if err == nil {
+			_, err = entity.delete(entity.id.ValueString(), nil)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting Automation Identity",
+				fmt.Sprintf("Could not delete %s %s, unexpected error: %s", strings.ReplaceAll(entity.entityType, "_", " "), entity.id.ValueString(), err.Error()),
+			)
+			// Keep the entities which remain, so that destroying again resumes the teardown
+			resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			return
+		}
+
+		r.events.record(ctx, entity.entityType, entity.id.ValueString(), eventActionDelete)
+		*entity.id = types.StringNull()
+	}
+}
+
+// teardownOrder returns the entities of the automation identity in the order in which they must be deleted: the Access
+// Policy referencing all others first, and the Trust Provider last.
+func (r *automationIdentityResource) teardownOrder(state *automationIdentityResourceModel) []automationIdentityEntity {
+	return []automationIdentityEntity{
+		{
+			entityType: "access_policy",
+			id:         &state.AccessPolicyID,
+			disable:    func(id string, h http.Header) error { _, err := r.client.DisableAccessPolicy(id, h); return err },
+			delete:     r.client.DeleteAccessPolicy,
+		},
+		{
+			entityType: "credential_provider",
+			id:         &state.CredentialProviderID,
+			disable:    func(id string, h http.Header) error { _, err := r.client.DisableCredentialProvider(id, h); return err },
+			delete:     r.client.DeleteCredentialProvider,
+		},
+		{
+			entityType: "server_workload",
+			id:         &state.ServerWorkloadID,
+			disable:    func(id string, h http.Header) error { _, err := r.client.DisableServerWorkload(id, h); return err },
+			delete:     r.client.DeleteServerWorkload,
+		},
+		{
+			entityType: "client_workload",
+			id:         &state.ClientWorkloadID,
+			disable:    func(id string, h http.Header) error { _, err := r.client.DisableClientWorkload(id, h); return err },
+			delete:     r.client.DeleteClientWorkload,
+		},
+		{
+			entityType: "trust_provider",
+			id:         &state.TrustProviderID,
+			disable:    func(id string, h http.Header) error { _, err := r.client.DisableTrustProvider(id, h); return err },
+			delete:     r.client.DeleteTrustProvider,
+		},
+	}
+}
+
+// invalidateCache drops the cached lists of the entity types of the automation identity.
+func (r *automationIdentityResource) invalidateCache() {
+	for _, entity := range r.teardownOrder(&automationIdentityResourceModel{}) {
+		r.cache.invalidate(entity.entityType)
+	}
+}
+
+// recordCreated records the creation of an entity of the automation identity and returns its ID.
+func (r *automationIdentityResource) recordCreated(ctx context.Context, entityType, id string) types.String {
+	r.events.record(ctx, entityType, id, eventActionCreate)
+	return types.StringValue(id)
+}
+
+// newAutomationIdentityClientWorkloadModel returns the Client Workload identified by the Client ID of the automation identity.
+func newAutomationIdentityClientWorkloadModel(ctx context.Context, plan automationIdentityResourceModel) clientWorkloadResourceModel {
+	identities, _ := types.SetValueFrom(ctx, TfIdentityObjectType, []identitiesModel{
+		{Type: types.StringValue("aembitClientId"), Value: plan.ClientID},
+	})
+	return clientWorkloadResourceModel{
+		Name:        plan.Name,
+		Description: types.StringValue(""),
+		IsActive:    types.BoolValue(true),
+		Identities:  identities,
+		Tags:        types.MapNull(types.StringType),
+	}
+}
+
+// newAutomationIdentityServerWorkloadModel returns the Server Workload of the Aembit API of the Tenant.
+func newAutomationIdentityServerWorkloadModel(plan automationIdentityResourceModel, tenant, stackDomain string) serverWorkloadResourceModel {
+	return serverWorkloadResourceModel{
+		Name:        plan.Name,
+		Description: types.StringValue(""),
+		IsActive:    types.BoolValue(true),
+		Tags:        types.MapNull(types.StringType),
+		ServiceEndpoint: &serviceEndpointModel{
+			Host:              types.StringValue(fmt.Sprintf("%s.api.%s", tenant, stackDomain)),
+			AppProtocol:       types.StringValue("HTTP"),
+			TransportProtocol: types.StringValue("TCP"),
+			RequestedPort:     types.Int64Value(443),
+			RequestedTLS:      types.BoolValue(true),
+			Port:              types.Int64Value(443),
+			TLS:               types.BoolValue(true),
+			TLSVerification:   types.StringValue("full"),
+			HTTPHeaders:       types.MapNull(types.StringType),
+			WorkloadServiceAuthentication: &workloadServiceAuthenticationModel{
+				Method: types.StringValue("HTTP Authentication"),
+				Scheme: types.StringValue("Bearer"),
+			},
+		},
+	}
+}
+
+// newAutomationIdentityAccessPolicyModel returns the Access Policy linking the entities of the automation identity.
+func newAutomationIdentityAccessPolicyModel(plan automationIdentityResourceModel) accessPolicyResourceModel {
+	return accessPolicyResourceModel{
+		IsActive:           types.BoolValue(true),
+		ClientWorkload:     plan.ClientWorkloadID,
+		TrustProviders:     []types.String{plan.TrustProviderID},
+		CredentialProvider: plan.CredentialProviderID,
+		ServerWorkload:     plan.ServerWorkloadID,
+	}
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAutomationIdentityResource(t *testing.T) {
+	createFile, _ := os.ReadFile("../../tests/automation/TestAccAutomationIdentityResource.tf")
+	modifyFile, _ := os.ReadFile("../../tests/automation/TestAccAutomationIdentityResource.tfmod")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: string(createFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aembit_automation_identity.github", "name", "TF Acceptance Automation Identity"),
+					resource.TestCheckResourceAttr("aembit_automation_identity.github", "github_action.repository", "aembit/terraform-provider-aembit"),
+					resource.TestMatchResourceAttr("aembit_automation_identity.github", "client_id", regexp.MustCompile(`^aembit:[^:]+:[^:]+:identity:github_idtoken:[0-9a-f-]{36}$`)),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("aembit_automation_identity.github", "id"),
+					resource.TestCheckResourceAttrSet("aembit_automation_identity.github", "trust_provider_id"),
+					resource.TestCheckResourceAttrSet("aembit_automation_identity.github", "client_workload_id"),
+					resource.TestCheckResourceAttrSet("aembit_automation_identity.github", "server_workload_id"),
+					resource.TestCheckResourceAttrSet("aembit_automation_identity.github", "credential_provider_id"),
+					resource.TestCheckResourceAttrSet("aembit_automation_identity.github", "access_policy_id"),
+				),
+			},
+			// Replace and Read testing
+			{
+				Config: string(modifyFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aembit_automation_identity.github", "name", "TF Acceptance Automation Identity - Modified"),
+					resource.TestCheckResourceAttr("aembit_automation_identity.github", "terraform_workspace.run_phase", "apply"),
+					resource.TestMatchResourceAttr("aembit_automation_identity.github", "client_id", regexp.MustCompile(`:identity:terraform_idtoken:`)),
+					resource.TestCheckResourceAttrSet("aembit_automation_identity.github", "access_policy_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAutomationIdentityEntities(t *testing.T) {
+	ctx := context.Background()
+	plan := automationIdentityResourceModel{
+		Name:                 types.StringValue("Terraform"),
+		ClientID:             types.StringValue("aembit:useast2:tenant:identity:github_idtoken:0bc4dbcd-e9c8-445b-ac90-28f47b8649cc"),
+		TrustProviderID:      types.StringValue("trust-provider"),
+		ClientWorkloadID:     types.StringValue("client-workload"),
+		ServerWorkloadID:     types.StringValue("server-workload"),
+		CredentialProviderID: types.StringValue("credential-provider"),
+	}
+
+	clientWorkload := convertClientWorkloadModelToDTO(ctx, newAutomationIdentityClientWorkloadModel(ctx, plan), nil)
+	if len(clientWorkload.Identities) != 1 || clientWorkload.Identities[0].Type != "aembitClientId" || clientWorkload.Identities[0].Value != plan.ClientID.ValueString() {
+		t.Errorf("unexpected client workload identities %v", clientWorkload.Identities)
+	}
+	if !clientWorkload.IsActive {
+		t.Error("expected an active client workload")
+	}
+
+	serverWorkload := convertServerWorkloadModelToDTO(ctx, newAutomationIdentityServerWorkloadModel(plan, "tenant", "useast2.aembit.io"), nil)
+	endpoint := serverWorkload.ServiceEndpoint
+	if endpoint.Host != "tenant.api.useast2.aembit.io" || endpoint.Port != 443 || !endpoint.TLS || endpoint.AppProtocol != "HTTP" {
+		t.Errorf("unexpected server workload endpoint %+v", endpoint)
+	}
+	if endpoint.WorkloadServiceAuthentication == nil || endpoint.WorkloadServiceAuthentication.Scheme != "Bearer" {
+		t.Errorf("expected Bearer authentication, got %+v", endpoint.WorkloadServiceAuthentication)
+	}
+
+	policy := convertAccessPolicyModelToPolicyDTO(newAutomationIdentityAccessPolicyModel(plan), nil)
+	if policy.ClientWorkload != "client-workload" || policy.ServerWorkload != "server-workload" || policy.CredentialProvider != "credential-provider" ||
+		len(policy.TrustProviders) != 1 || policy.TrustProviders[0] != "trust-provider" || !policy.IsActive {
+		t.Errorf("unexpected access policy %+v", policy)
+	}
+}
+
+func TestAutomationIdentityTeardownOrder(t *testing.T) {
+	state := automationIdentityResourceModel{}
+	want := []struct {
+		entityType string
+		id         *types.String
+	}{
+		{"access_policy", &state.AccessPolicyID},
+		{"credential_provider", &state.CredentialProviderID},
+		{"server_workload", &state.ServerWorkloadID},
+		{"client_workload", &state.ClientWorkloadID},
+		{"trust_provider", &state.TrustProviderID},
+	}
+
+	got := (&automationIdentityResource{client: &CloudClient{}}).teardownOrder(&state)
+	if len(got) != len(want) {
+		t.Fatalf("teardownOrder returned %d entities, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].entityType != want[i].entityType || got[i].id != want[i].id {
+			t.Errorf("teardownOrder[%d] = %s, want %s", i, got[i].entityType, want[i].entityType)
+		}
+	}
+}
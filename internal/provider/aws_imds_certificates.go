@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// awsIMDSCertificateDir is the directory of the embedded AWS instance identity certificates, one <region>.pem file
+// per AWS region.
+const awsIMDSCertificateDir = "aws_imds_certificates"
+
+//go:embed aws_imds_certificates
+var awsIMDSCertificateFiles embed.FS
+
+// awsIMDSCertificates maps an AWS region to the PEM certificate AWS publishes to verify the signature of the instance
+// identity documents of that region.
+var awsIMDSCertificates = loadAWSIMDSCertificates(awsIMDSCertificateFiles)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ validator.String = awsIMDSCertificateRegionValidator{}
+)
+
+// loadAWSIMDSCertificates reads the <region>.pem certificates of the awsIMDSCertificateDir directory of files.
+func loadAWSIMDSCertificates(files fs.FS) map[string]string {
+	certificates := make(map[string]string)
+	names, _ := fs.Glob(files, awsIMDSCertificateDir+"/*.pem")
+	for _, name := range names {
+		certificate, err := fs.ReadFile(files, name)
+		if err != nil {
+			continue
+		}
+		certificates[strings.TrimSuffix(path.Base(name), ".pem")] = string(certificate)
+	}
+	return certificates
+}
+
+// awsIMDSCertificateRegions returns the sorted regions with an embedded AWS instance identity certificate.
+func awsIMDSCertificateRegions() []string {
+	regions := make([]string, 0, len(awsIMDSCertificates))
+	for region := range awsIMDSCertificates {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// awsIMDSCertificateRegionValidator validates that a string is an AWS region with an embedded instance identity
+// certificate.
+type awsIMDSCertificateRegionValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v awsIMDSCertificateRegionValidator) Description(_ context.Context) string {
+	return "value must be an AWS region with an embedded instance identity certificate"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v awsIMDSCertificateRegionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v awsIMDSCertificateRegionValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, ok := awsIMDSCertificates[req.ConfigValue.ValueString()]; ok {
+		return
+	}
+	supported := "none, set the certificate instead"
+	if regions := awsIMDSCertificateRegions(); len(regions) > 0 {
+		supported = strings.Join(regions, ", ")
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Unsupported AWS Region",
+		fmt.Sprintf("No AWS instance identity certificate is embedded for region %q. Supported regions: %s.", req.ConfigValue.ValueString(), supported),
+	)
+}
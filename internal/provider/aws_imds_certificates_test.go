@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"testing"
+	"testing/fstest"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testAWSCertificate returns the AWS instance identity certificate of the AWS Metadata acceptance test configuration.
+func testAWSCertificate(t *testing.T) string {
+	t.Helper()
+
+	config, _ := os.ReadFile("../../tests/trust/aws/TestAccTrustProviderResource.tf")
+	certificate := regexp.MustCompile(`(?s)-----BEGIN CERTIFICATE-----.*?-----END CERTIFICATE-----\r?\n`).Find(config)
+	if certificate == nil {
+		t.Fatal("expected a certificate in the AWS Metadata acceptance test configuration")
+	}
+	return string(certificate)
+}
+
+// withAWSIMDSCertificates replaces the embedded AWS instance identity certificates for the duration of the test.
+func withAWSIMDSCertificates(t *testing.T, certificates map[string]string) {
+	t.Helper()
+
+	embedded := awsIMDSCertificates
+	awsIMDSCertificates = certificates
+	t.Cleanup(func() { awsIMDSCertificates = embedded })
+}
+
+func TestAWSIMDSCertificates(t *testing.T) {
+	for region, certificate := range awsIMDSCertificates {
+		if err := parsePEMCertificate(certificate); err != nil {
+			t.Errorf("%s: invalid embedded certificate: %v", region, err)
+		}
+	}
+}
+
+func TestLoadAWSIMDSCertificates(t *testing.T) {
+	certificate := testAWSCertificate(t)
+	files := fstest.MapFS{
+		"aws_imds_certificates/README.md":         {Data: []byte("# AWS instance identity certificates")},
+		"aws_imds_certificates/us-east-1.pem":     {Data: []byte(certificate)},
+		"aws_imds_certificates/us-gov-west-1.pem": {Data: []byte(certificate)},
+		"aws_imds_certificates/cn-north-1.pem":    {Data: []byte(certificate)},
+	}
+
+	certificates := loadAWSIMDSCertificates(files)
+	if len(certificates) != 3 {
+		t.Fatalf("expected one certificate per .pem file, got %v", certificates)
+	}
+	for _, region := range []string{"us-east-1", "us-gov-west-1", "cn-north-1"} {
+		if certificates[region] != certificate {
+			t.Errorf("expected the certificate of %s, got %q", region, certificates[region])
+		}
+	}
+}
+
+func TestAWSIMDSCertificateRegionValidator(t *testing.T) {
+	withAWSIMDSCertificates(t, map[string]string{"us-east-1": testAWSCertificate(t)})
+
+	cases := map[string]struct {
+		value   types.String
+		isValid bool
+	}{
+		"embedded": {types.StringValue("us-east-1"), true},
+		"null":     {types.StringNull(), true},
+		"unknown":  {types.StringUnknown(), true},
+		"missing":  {types.StringValue("us-west-2"), false},
+		"empty":    {types.StringValue(""), false},
+	}
+	for name, c := range cases {
+		req := validator.StringRequest{Path: path.Root("certificate_region"), ConfigValue: c.value}
+		var resp validator.StringResponse
+		awsIMDSCertificateRegionValidator{}.ValidateString(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() == c.isValid {
+			t.Errorf("%s: expected valid %t, got %v", name, c.isValid, resp.Diagnostics)
+		}
+	}
+}
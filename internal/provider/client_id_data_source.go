@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"aembit.io/aembit"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// clientIDIdentityTypes maps the Aembit Trust Provider types which can attest an Aembit Client ID to the identity type
+// of the Client ID.
+var clientIDIdentityTypes = map[string]string{
+	"GcpIdentityToken":       "gcp_idtoken",
+	"GitHubIdentityToken":    "github_idtoken",
+	"TerraformIdentityToken": "terraform_idtoken",
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &clientIDDataSource{}
+	_ datasource.DataSourceWithConfigure = &clientIDDataSource{}
+)
+
+// NewClientIDDataSource is a helper function to simplify the provider implementation.
+func NewClientIDDataSource() datasource.DataSource {
+	return &clientIDDataSource{}
+}
+
+// clientIDDataSource is the data source implementation.
+type clientIDDataSource struct {
+	client *CloudClient
+	cache  *readCache
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *clientIDDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*aembitProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+	d.cache = data.cache
+}
+
+// Metadata returns the data source type name.
+func (d *clientIDDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_id"
+}
+
+// Schema defines the schema for the data source.
+func (d *clientIDDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the Aembit Client ID with which a Client Workload authenticates through a Trust Provider, for use as the provider `client_id`.",
+		Attributes: map[string]schema.Attribute{
+			"client_workload_id": schema.StringAttribute{
+				Description: "Unique identifier of the Client Workload, which must have an `aembitClientId` identity.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"trust_provider_id": schema.StringAttribute{
+				Description: "Unique identifier of the Trust Provider. Must be a `gcp_identity`, `github_action` or `terraform_workspace` Trust Provider.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				Description: "Aembit Client ID (e.g. `aembit:useast2:tenant:identity:github_idtoken:<id>`).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read looks up the Client Workload and Trust Provider and returns the matching Aembit Client ID.
+func (d *clientIDDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state clientIDDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientWorkload, err := cachedGet(d.cache, "client_workload", state.ClientWorkloadID.ValueString(), d.client.GetClientWorkloads, d.client.GetClientWorkload, func(e aembit.ClientWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO })
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Aembit Client Workload",
+			"Could not read Aembit Client Workload "+state.ClientWorkloadID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	trustProvider, err := cachedGet(d.cache, "trust_provider", state.TrustProviderID.ValueString(), d.client.GetTrustProviders, d.client.GetTrustProvider, func(e aembit.TrustProviderDTO) aembit.EntityDTO { return e.EntityDTO })
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Aembit Trust Provider",
+			"Could not read Aembit Trust Provider "+state.TrustProviderID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	clientID, err := getClientWorkloadClientID(clientWorkload, trustProvider, d.client.Tenant, d.client.StackDomain)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Determine Aembit Client ID",
+			err.Error(),
+		)
+		return
+	}
+	state.ClientID = types.StringValue(clientID)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// getClientWorkloadClientID returns the aembitClientId identity of the Client Workload which the Trust Provider can
+// attest on the given Tenant and stack.
+func getClientWorkloadClientID(clientWorkload aembit.ClientWorkloadExternalDTO, trustProvider aembit.TrustProviderDTO, tenant, stackDomain string) (string, error) {
+	identityType, ok := clientIDIdentityTypes[trustProvider.Provider]
+	if !ok {
+		return "", fmt.Errorf("trust provider %q of type %s cannot attest an Aembit Client ID, use a gcp_identity, github_action or terraform_workspace Trust Provider",
+			trustProvider.Name, trustProviderTypes[trustProvider.Provider])
+	}
+
+	stack, _, _ := strings.Cut(stackDomain, ".")
+	for _, identity := range clientWorkload.Identities {
+		if identity.Type != "aembitClientId" || getAembitIdentityType(identity.Value) != identityType {
+			continue
+		}
+		clientIDSplit := strings.Split(identity.Value, ":")
+		if len(clientIDSplit) != 6 || clientIDSplit[0] != "aembit" {
+			return "", fmt.Errorf("client workload %q has an invalid aembitClientId identity %q", clientWorkload.Name, identity.Value)
+		}
+		if (len(tenant) > 0 && clientIDSplit[2] != tenant) || (len(stack) > 0 && clientIDSplit[1] != stack) {
+			return "", fmt.Errorf("the aembitClientId identity %q of client workload %q does not belong to the %s Tenant on the %s stack", identity.Value, clientWorkload.Name, tenant, stack)
+		}
+		return identity.Value, nil
+	}
+
+	return "", fmt.Errorf("client workload %q has no aembitClientId identity for %s Trust Providers, add an identity with the value %s",
+		clientWorkload.Name, trustProviderTypes[trustProvider.Provider], newAembitClientID(stackDomain, tenant, identityType, "<unique id>"))
+}
+
+// newAembitClientID formats the Aembit Client ID of the given identity type and identifier on the Tenant and stack.
+func newAembitClientID(stackDomain, tenant, identityType, id string) string {
+	stack, _, _ := strings.Cut(stackDomain, ".")
+	return fmt.Sprintf("aembit:%s:%s:identity:%s:%s", stack, tenant, identityType, id)
+}
+
+// getClientWorkloadIdentityClientID returns the value of the first aembitClientId identity, or null if there is none.
+func getClientWorkloadIdentityClientID(identities []aembit.ClientWorkloadIdentityDTO) types.String {
+	for _, identity := range identities {
+		if identity.Type == "aembitClientId" {
+			return types.StringValue(identity.Value)
+		}
+	}
+	return types.StringNull()
+}
+
+// getTrustProviderClientIDType returns the identity type of the Aembit Client IDs attested by the Trust Provider type,
+// or null if it cannot attest Client IDs.
+func getTrustProviderClientIDType(provider string) types.String {
+	if identityType, ok := clientIDIdentityTypes[provider]; ok {
+		return types.StringValue(identityType)
+	}
+	return types.StringNull()
+}
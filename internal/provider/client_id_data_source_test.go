@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"aembit.io/aembit"
+)
+
+func TestGetClientWorkloadClientID(t *testing.T) {
+	const githubClientID = "aembit:useast2:tenant:identity:github_idtoken:0bc4dbcd-e9c8-445b-ac90-28f47b8649cc"
+	const terraformClientID = "aembit:useast2:tenant:identity:terraform_idtoken:8dc0a8b3-3ed1-4a9e-9a57-1a0c4c7d7f46"
+
+	clientWorkload := aembit.ClientWorkloadExternalDTO{
+		EntityDTO: aembit.EntityDTO{Name: "Terraform"},
+		Identities: []aembit.ClientWorkloadIdentityDTO{
+			{Type: "githubIdTokenRepository", Value: "example/infrastructure"},
+			{Type: "aembitClientId", Value: githubClientID},
+			{Type: "aembitClientId", Value: terraformClientID},
+		},
+	}
+
+	cases := map[string]string{
+		"GitHubIdentityToken":    githubClientID,
+		"TerraformIdentityToken": terraformClientID,
+	}
+	for provider, want := range cases {
+		trustProvider := aembit.TrustProviderDTO{EntityDTO: aembit.EntityDTO{Name: provider}, Provider: provider}
+		got, err := getClientWorkloadClientID(clientWorkload, trustProvider, "tenant", "useast2.aembit.io")
+		if err != nil || got != want {
+			t.Errorf("getClientWorkloadClientID(%s) = %q, %v, want %q", provider, got, err, want)
+		}
+	}
+
+	errorCases := map[string]struct {
+		provider    string
+		tenant      string
+		stackDomain string
+		contains    string
+	}{
+		"unsupported trust provider": {"KubernetesServiceAccount", "tenant", "useast2.aembit.io", "cannot attest"},
+		"missing identity":           {"GcpIdentityToken", "tenant", "useast2.aembit.io", "aembit:useast2:tenant:identity:gcp_idtoken:"},
+		"other tenant":               {"GitHubIdentityToken", "other", "useast2.aembit.io", "does not belong"},
+		"other stack":                {"GitHubIdentityToken", "tenant", "euc1.aembit.io", "does not belong"},
+	}
+	for name, c := range errorCases {
+		trustProvider := aembit.TrustProviderDTO{EntityDTO: aembit.EntityDTO{Name: name}, Provider: c.provider}
+		if _, err := getClientWorkloadClientID(clientWorkload, trustProvider, c.tenant, c.stackDomain); err == nil || !strings.Contains(err.Error(), c.contains) {
+			t.Errorf("%s: getClientWorkloadClientID error = %v, want it to contain %q", name, err, c.contains)
+		}
+	}
+}
+
+func TestNewAembitClientID(t *testing.T) {
+	got := newAembitClientID("useast2.aembit.io", "tenant", "github_idtoken", "0bc4dbcd-e9c8-445b-ac90-28f47b8649cc")
+	want := "aembit:useast2:tenant:identity:github_idtoken:0bc4dbcd-e9c8-445b-ac90-28f47b8649cc"
+	if got != want {
+		t.Errorf("newAembitClientID = %s, want %s", got, want)
+	}
+	if getAembitTenantId(got) != "tenant" || getAembitIdentityType(got) != "github_idtoken" {
+		t.Errorf("newAembitClientID = %s, does not parse back to its tenant and identity type", got)
+	}
+}
+
+func TestGetClientWorkloadIdentityClientID(t *testing.T) {
+	const clientID = "aembit:useast2:tenant:identity:github_idtoken:0bc4dbcd-e9c8-445b-ac90-28f47b8649cc"
+
+	got := getClientWorkloadIdentityClientID([]aembit.ClientWorkloadIdentityDTO{
+		{Type: "hostname", Value: "build.example.com"},
+		{Type: "aembitClientId", Value: clientID},
+	})
+	if got.ValueString() != clientID {
+		t.Errorf("getClientWorkloadIdentityClientID = %s, want %s", got, clientID)
+	}
+
+	if got := getClientWorkloadIdentityClientID([]aembit.ClientWorkloadIdentityDTO{{Type: "hostname", Value: "build.example.com"}}); !got.IsNull() {
+		t.Errorf("getClientWorkloadIdentityClientID = %s, want null", got)
+	}
+}
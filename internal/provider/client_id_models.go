@@ -0,0 +1,12 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// clientIDDataSourceModel maps the data source schema.
+type clientIDDataSourceModel struct {
+	ClientWorkloadID types.String `tfsdk:"client_workload_id"`
+	TrustProviderID  types.String `tfsdk:"trust_provider_id"`
+	ClientID         types.String `tfsdk:"client_id"`
+}
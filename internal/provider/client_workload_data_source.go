@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"aembit.io/aembit"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &clientWorkloadDataSource{}
+	_ datasource.DataSourceWithConfigure = &clientWorkloadDataSource{}
+)
+
+// NewClientWorkloadDataSource is a helper function to simplify the provider implementation.
+func NewClientWorkloadDataSource() datasource.DataSource {
+	return &clientWorkloadDataSource{}
+}
+
+// clientWorkloadDataSource is the data source implementation.
+type clientWorkloadDataSource struct {
+	client *CloudClient
+	cache  *readCache
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *clientWorkloadDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*aembitProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+	d.cache = data.cache
+}
+
+// Metadata returns the data source type name.
+func (d *clientWorkloadDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_workload"
+}
+
+// Schema defines the schema for the data source.
+func (d *clientWorkloadDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema, resp.Diagnostics = entitySchema(ctx, NewClientWorkloadsDataSource(), "client_workloads", "Looks up a single client workload by id or name.", true)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *clientWorkloadDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	id, name, diags := getEntityLookup(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientWorkloads, err := cachedList(d.cache, "client_workload", d.client.GetClientWorkloads, func(e aembit.ClientWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO })
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Aembit Client Workloads",
+			err.Error(),
+		)
+		return
+	}
+
+	clientWorkload, err := findEntity(clientWorkloads, id, name, "client workload", func(e aembit.ClientWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO })
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Find Aembit Client Workload",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state := convertClientWorkloadDTOToModel(ctx, clientWorkload)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -14,11 +14,15 @@ type clientWorkloadResourceModel struct {
 	IsActive    types.Bool   `tfsdk:"is_active"`
 	Identities  types.Set    `tfsdk:"identities"`
 	Tags        types.Map    `tfsdk:"tags"`
+	ClientID    types.String `tfsdk:"client_id"`
 }
 
 // clientWorkloadDataSourceModel maps the datasource schema.
 type clientWorkloadsDataSourceModel struct {
 	ClientWorkloads []clientWorkloadResourceModel `tfsdk:"client_workloads"`
+	NameRegex       types.String                  `tfsdk:"name_regex"`
+	Tags            types.Map                     `tfsdk:"tags"`
+	IsActive        types.Bool                    `tfsdk:"is_active"`
 }
 
 // identitiesModel maps client workload identity data.
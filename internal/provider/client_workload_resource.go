@@ -27,7 +27,9 @@ func NewClientWorkloadResource() resource.Resource {
 
 // clientWorkloadResource is the resource implementation.
 type clientWorkloadResource struct {
-	client *aembit.CloudClient
+	client *CloudClient
+	cache  *readCache
+	events *eventRecorder
 }
 
 // Metadata returns the resource type name.
@@ -41,18 +43,20 @@ func (r *clientWorkloadResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*aembit.CloudClient)
+	data, ok := req.ProviderData.(*aembitProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *aembit.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.cache = data.cache
+	r.events = data.events
 }
 
 // Schema defines the schema for the resource.
@@ -142,6 +146,10 @@ func (r *clientWorkloadResource) Schema(_ context.Context, _ resource.SchemaRequ
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"client_id": schema.StringAttribute{
+				Description: "Aembit Client ID of the `aembitClientId` identity, if any, for use as the provider `client_id`.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -159,6 +167,8 @@ func (r *clientWorkloadResource) Create(ctx context.Context, req resource.Create
 	// Generate API request body from plan
 	var workload aembit.ClientWorkloadExternalDTO = convertClientWorkloadModelToDTO(ctx, plan, nil)
 
+	defer r.cache.invalidate("client_workload")
+
 	// Create new Client Workload
 	clientWorkload, err := r.client.CreateClientWorkload(workload, nil)
 	if err != nil {
@@ -178,6 +188,8 @@ func (r *clientWorkloadResource) Create(ctx context.Context, req resource.Create
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.events.record(ctx, "client_workload", plan.ID.ValueString(), eventActionCreate)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -191,7 +203,7 @@ func (r *clientWorkloadResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get refreshed workload value from Aembit
-	clientWorkload, err := r.client.GetClientWorkload(state.ID.ValueString(), nil)
+	clientWorkload, err := cachedGet(r.cache, "client_workload", state.ID.ValueString(), r.client.GetClientWorkloads, r.client.GetClientWorkload, func(e aembit.ClientWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO })
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Aembit Client Workload",
@@ -235,6 +247,8 @@ func (r *clientWorkloadResource) Update(ctx context.Context, req resource.Update
 	// Generate API request body from plan
 	var workload aembit.ClientWorkloadExternalDTO = convertClientWorkloadModelToDTO(ctx, plan, &externalID)
 
+	defer r.cache.invalidate("client_workload")
+
 	// Update Client Workload
 	clientWorkload, err := r.client.UpdateClientWorkload(workload, nil)
 	if err != nil {
@@ -254,6 +268,8 @@ func (r *clientWorkloadResource) Update(ctx context.Context, req resource.Update
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.events.record(ctx, "client_workload", state.ID.ValueString(), eventActionUpdate)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -266,6 +282,8 @@ func (r *clientWorkloadResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	defer r.cache.invalidate("client_workload")
+
 	// Check if Client Workload is Active - if it is, disable it first
 	if state.IsActive == types.BoolValue(true) {
 		_, err := r.client.DisableClientWorkload(state.ID.ValueString(), nil)
@@ -287,6 +305,8 @@ func (r *clientWorkloadResource) Delete(ctx context.Context, req resource.Delete
 		)
 		return
 	}
+
+	r.events.record(ctx, "client_workload", state.ID.ValueString(), eventActionDelete)
 }
 
 // Imports an existing resource by passing externalId.
@@ -343,6 +363,7 @@ func convertClientWorkloadDTOToModel(ctx context.Context, dto aembit.ClientWorkl
 	model.IsActive = types.BoolValue(dto.EntityDTO.IsActive)
 	model.Identities = newClientWorkloadIdentityModel(ctx, dto.Identities)
 	model.Tags = newTagsModel(ctx, dto.EntityDTO.Tags)
+	model.ClientID = getClientWorkloadIdentityClientID(dto.Identities)
 
 	return model
 }
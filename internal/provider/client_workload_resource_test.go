@@ -33,6 +33,7 @@ func TestAccClientWorkloadResource(t *testing.T) {
 					resource.TestCheckResourceAttr("aembit_client_workload.test", "identities.#", "1"),
 					resource.TestCheckResourceAttr("aembit_client_workload.test", "identities.0.type", "k8sNamespace"),
 					resource.TestCheckResourceAttr("aembit_client_workload.test", "identities.0.value", fmt.Sprintf("unittest1namespace%d", randID)),
+					resource.TestCheckNoResourceAttr("aembit_client_workload.test", "client_id"),
 					// Verify Tags.
 					resource.TestCheckResourceAttr("aembit_client_workload.test", "tags.%", "2"),
 					resource.TestCheckResourceAttr("aembit_client_workload.test", "tags.color", "blue"),
@@ -23,7 +23,8 @@ func NewClientWorkloadsDataSource() datasource.DataSource {
 
 // clientWorkloadsDataSource is the data source implementation.
 type clientWorkloadsDataSource struct {
-	client *aembit.CloudClient
+	client *CloudClient
+	cache  *readCache
 }
 
 // Configure adds the provider configured client to the data source.
@@ -32,17 +33,18 @@ func (d *clientWorkloadsDataSource) Configure(_ context.Context, req datasource.
 		return
 	}
 
-	client, ok := req.ProviderData.(*aembit.CloudClient)
+	data, ok := req.ProviderData.(*aembitProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *aembit.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.cache = data.cache
 }
 
 // Metadata returns the data source type name.
@@ -98,18 +100,35 @@ func (r *clientWorkloadsDataSource) Schema(_ context.Context, _ datasource.Schem
 							Optional:    true,
 							Computed:    true,
 						},
+						"client_id": schema.StringAttribute{
+							Description: "Aembit Client ID of the `aembitClientId` identity, if any.",
+							Computed:    true,
+						},
 					},
 				},
 			},
 		},
 	}
+
+	addEntityFilterAttributes(resp.Schema.Attributes, "client workloads")
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *clientWorkloadsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state clientWorkloadsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newEntityFilter(ctx, state.NameRegex, state.Tags, state.IsActive)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	clientWorkloads, err := d.client.GetClientWorkloads(nil)
+	clientWorkloads, err := cachedList(d.cache, "client_workload", d.client.GetClientWorkloads, func(e aembit.ClientWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO })
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Aembit Client Workloads",
@@ -120,12 +139,15 @@ func (d *clientWorkloadsDataSource) Read(ctx context.Context, req datasource.Rea
 
 	// Map response body to model
 	for _, clientWorkload := range clientWorkloads {
+		if !filter.matches(clientWorkload.EntityDTO) {
+			continue
+		}
 		clientWorkloadState := convertClientWorkloadDTOToModel(ctx, clientWorkload)
 		state.ClientWorkloads = append(state.ClientWorkloads, clientWorkloadState)
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// NewCloudClient creates an Aembit API client outside of a Terraform run (e.g. for the provider subcommands).
+// The tenant and credentials are resolved from the same environment variables supported by the provider block:
+// AEMBIT_TENANT_ID and AEMBIT_TOKEN, or AEMBIT_CLIENT_ID for Trust Provider Attestation Authentication.
+// Lists are read a page at a time, with the page size of the AEMBIT_PAGE_SIZE environment variable.
+func NewCloudClient(version string) (*CloudClient, error) {
+	tenant := os.Getenv("AEMBIT_TENANT_ID")
+	token := os.Getenv("AEMBIT_TOKEN")
+	stackDomain := os.Getenv("AEMBIT_STACK_DOMAIN")
+	if len(stackDomain) == 0 {
+		stackDomain = "useast2.aembit.io"
+	}
+
+	if aembitClientID := os.Getenv("AEMBIT_CLIENT_ID"); len(aembitClientID) > 0 {
+		tenant = getAembitTenantId(aembitClientID)
+		idToken, err := getIdentityToken(aembitClientID, stackDomain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get id token: %w", err)
+		}
+		aembitToken, err := getAembitToken(aembitClientID, stackDomain, idToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get aembit token: %w", err)
+		}
+		token, err = getAembitCredential(context.Background(), fmt.Sprintf("%s.api.%s", tenant, stackDomain), 443, "TCP", aembitClientID, stackDomain, idToken, aembitToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get aembit api role token: %w", err)
+		}
+	}
+
+	if tenant == "" {
+		return nil, fmt.Errorf("missing aembit tenant, set AEMBIT_TENANT_ID or AEMBIT_CLIENT_ID")
+	}
+	if token == "" {
+		return nil, fmt.Errorf("missing aembit access token, set AEMBIT_TOKEN or AEMBIT_CLIENT_ID")
+	}
+
+	pageSize, err := getPageSize()
+	if err != nil {
+		return nil, err
+	}
+	return newCloudClient(tenant, stackDomain, token, version, pageSize)
+}
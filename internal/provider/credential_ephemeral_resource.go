@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &credentialEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &credentialEphemeralResource{}
+)
+
+// NewCredentialEphemeralResource is a helper function to simplify the provider implementation.
+func NewCredentialEphemeralResource() ephemeral.EphemeralResource {
+	return &credentialEphemeralResource{}
+}
+
+// credentialEphemeralResource is the ephemeral resource implementation.
+type credentialEphemeralResource struct {
+	edge *aembitEdgeClient
+}
+
+// Configure adds the provider configured Edge client to the ephemeral resource.
+func (r *credentialEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*aembitProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.edge = data.edge
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *credentialEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *credentialEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves a credential for a Server Workload from Aembit Edge, using the Trust Provider attestation of the provider `client_id`. The credential is never persisted to the Terraform plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"target_host": schema.StringAttribute{
+				Description: "Hostname of the Server Workload.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"target_port": schema.Int64Attribute{
+				Description: "Port of the Server Workload.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"transport_protocol": schema.StringAttribute{
+				Description: "Transport Protocol of the Server Workload. Defaults to `TCP`.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"TCP", "UDP"}...),
+				},
+			},
+			"credential": schema.StringAttribute{
+				Description: "Credential issued by Aembit for the Server Workload.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Open retrieves the credential from Aembit Edge.
+func (r *credentialEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data credentialEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.edge == nil || len(r.edge.ClientID) == 0 {
+		resp.Diagnostics.AddError(
+			"Missing Aembit Client ID",
+			"The aembit_credential ephemeral resource requires the provider to authenticate with a Trust Provider. "+
+				"Set the client_id value in the provider configuration or use the AEMBIT_CLIENT_ID environment variable.",
+		)
+		return
+	}
+
+	if data.TransportProtocol.IsNull() || data.TransportProtocol.IsUnknown() {
+		data.TransportProtocol = types.StringValue("TCP")
+	}
+
+	credential, err := getEdgeCredential(ctx, data.TargetHost.ValueString(), int32(data.TargetPort.ValueInt64()), data.TransportProtocol.ValueString(), r.edge.ClientID, r.edge.StackDomain)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Retrieve Aembit Credential",
+			fmt.Sprintf("Could not retrieve credential for %s:%d, unexpected error: %s", data.TargetHost.ValueString(), data.TargetPort.ValueInt64(), err.Error()),
+		)
+		return
+	}
+	data.Credential = types.StringValue(credential)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
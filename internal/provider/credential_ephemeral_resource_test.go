@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRequestAembitCredential(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeEdgeCommanderClient{}
+	target := ClientRequestNetwork{TargetHost: "postgres.example.com", TargetPort: 5432, TransportProtocol: "TCP"}
+
+	credential, err := requestAembitCredential(ctx, client, target, "{}")
+	if err != nil || credential != "credential-1" {
+		t.Fatalf("requestAembitCredential = %q, %v, want credential-1", credential, err)
+	}
+
+	var request ClientRequest
+	if err = json.Unmarshal([]byte(client.requests[0]), &request); err != nil {
+		t.Fatalf("unexpected client request %s: %v", client.requests[0], err)
+	}
+	if request.Network != target {
+		t.Errorf("requested %+v, want %+v", request.Network, target)
+	}
+
+	// The request is made with the context of the Terraform operation, so it stops when the operation is cancelled
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err = requestAembitCredential(cancelled, client, target, "{}"); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the request to be cancelled, got %v", err)
+	}
+	if len(client.requests) != 1 {
+		t.Errorf("expected 1 request, got %d", len(client.requests))
+	}
+}
+
+func TestCredentialEphemeralResourceOpen(t *testing.T) {
+	ctx := context.Background()
+
+	for name, edge := range map[string]*aembitEdgeClient{
+		"unconfigured":     nil,
+		"missing clientID": {StackDomain: "useast2.aembit.io"},
+	} {
+		r := &credentialEphemeralResource{edge: edge}
+
+		var schemaResp ephemeral.SchemaResponse
+		r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+		schemaType := schemaResp.Schema.Type().TerraformType(ctx)
+
+		req := ephemeral.OpenRequest{
+			Config: tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+					"target_host":        tftypes.NewValue(tftypes.String, "postgres.example.com"),
+					"target_port":        tftypes.NewValue(tftypes.Number, 5432),
+					"transport_protocol": tftypes.NewValue(tftypes.String, nil),
+					"credential":         tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		}
+		resp := ephemeral.OpenResponse{
+			Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, nil)},
+		}
+		r.Open(ctx, req, &resp)
+
+		if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Missing Aembit Client ID" {
+			t.Errorf("%s: expected a missing client ID error, got %v", name, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// credentialEphemeralResourceModel maps the ephemeral resource schema.
+type credentialEphemeralResourceModel struct {
+	TargetHost        types.String `tfsdk:"target_host"`
+	TargetPort        types.Int64  `tfsdk:"target_port"`
+	TransportProtocol types.String `tfsdk:"transport_protocol"`
+	Credential        types.String `tfsdk:"credential"`
+}
+
+// credentialsEphemeralResourceModel maps the ephemeral resource schema.
+type credentialsEphemeralResourceModel struct {
+	Targets     []credentialsTargetModel `tfsdk:"targets"`
+	Credentials types.Map                `tfsdk:"credentials"`
+}
+
+// credentialsTargetModel maps a single Server Workload target.
+type credentialsTargetModel struct {
+	TargetHost        types.String `tfsdk:"target_host"`
+	TargetPort        types.Int64  `tfsdk:"target_port"`
+	TransportProtocol types.String `tfsdk:"transport_protocol"`
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"aembit.io/aembit"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &credentialProviderDataSource{}
+	_ datasource.DataSourceWithConfigure = &credentialProviderDataSource{}
+)
+
+// NewCredentialProviderDataSource is a helper function to simplify the provider implementation.
+func NewCredentialProviderDataSource() datasource.DataSource {
+	return &credentialProviderDataSource{}
+}
+
+// credentialProviderDataSource is the data source implementation.
+type credentialProviderDataSource struct {
+	client *CloudClient
+	cache  *readCache
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *credentialProviderDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*aembitProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+	d.cache = data.cache
+}
+
+// Metadata returns the data source type name.
+func (d *credentialProviderDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_provider"
+}
+
+// Schema defines the schema for the data source.
+func (d *credentialProviderDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema, resp.Diagnostics = entitySchema(ctx, NewCredentialProvidersDataSource(), "credential_providers", "Looks up a single credential provider by id or name.", true)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *credentialProviderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	id, name, diags := getEntityLookup(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentialProviders, err := cachedList(d.cache, "credential_provider", d.client.GetCredentialProviders, func(e aembit.CredentialProviderDTO) aembit.EntityDTO { return e.EntityDTO })
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Aembit Credential Providers",
+			err.Error(),
+		)
+		return
+	}
+
+	credentialProvider, err := findEntity(credentialProviders, id, name, "credential provider", func(e aembit.CredentialProviderDTO) aembit.EntityDTO { return e.EntityDTO })
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Find Aembit Credential Provider",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state := convertCredentialProviderDTOToModel(ctx, credentialProvider, credentialProviderResourceModel{}, d.client.Tenant, d.client.StackDomain)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -25,6 +25,10 @@ type credentialProviderResourceModel struct {
 // credentialProviderDataSourceModel maps the datasource schema.
 type credentialProvidersDataSourceModel struct {
 	CredentialProviders []credentialProviderResourceModel `tfsdk:"credential_providers"`
+	NameRegex           types.String                      `tfsdk:"name_regex"`
+	Tags                types.Map                         `tfsdk:"tags"`
+	IsActive            types.Bool                        `tfsdk:"is_active"`
+	Type                types.String                      `tfsdk:"type"`
 }
 
 type credentialProviderAembitTokenModel struct {
@@ -55,6 +59,7 @@ type credentialProviderSnowflakeTokenModel struct {
 	AccountID        types.String `tfsdk:"account_id"`
 	Username         types.String `tfsdk:"username"`
 	AlertUserCommand types.String `tfsdk:"alter_user_command"`
+	PublicKey        pemValue     `tfsdk:"public_key"`
 }
 
 // credentialProviderOAuthClientCredentialsModel maps OAuth Client Credentials Flow configuration.
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -32,7 +33,9 @@ func NewCredentialProviderResource() resource.Resource {
 
 // credentialProviderResource is the resource implementation.
 type credentialProviderResource struct {
-	client *aembit.CloudClient
+	client *CloudClient
+	cache  *readCache
+	events *eventRecorder
 }
 
 // Metadata returns the resource type name.
@@ -46,18 +49,20 @@ func (r *credentialProviderResource) Configure(_ context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*aembit.CloudClient)
+	data, ok := req.ProviderData.(*aembitProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *aembit.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.cache = data.cache
+	r.events = data.events
 }
 
 // Schema defines the schema for the resource.
@@ -184,6 +189,11 @@ func (r *credentialProviderResource) Schema(_ context.Context, _ resource.Schema
 						Description: "Snowflake Alter User Command generated for configuration of Snowflake by the Credential Provider.",
 						Computed:    true,
 					},
+					"public_key": schema.StringAttribute{
+						CustomType:  pemType{},
+						Description: "PEM encoded RSA Public Key generated by the Credential Provider, which Snowflake uses to verify the JSON Web Token.",
+						Computed:    true,
+					},
 				},
 			},
 			"oauth_client_credentials": schema.SingleNestedAttribute{
@@ -344,6 +354,8 @@ func (r *credentialProviderResource) Create(ctx context.Context, req resource.Cr
 	// Generate API request body from plan
 	var credential aembit.CredentialProviderDTO = convertCredentialProviderModelToDTO(ctx, plan, nil, r.client.Tenant, r.client.StackDomain)
 
+	defer r.cache.invalidate("credential_provider")
+
 	// Create new Credential Provider
 	credentialProvider, err := r.client.CreateCredentialProvider(credential, nil)
 	if err != nil {
@@ -363,6 +375,8 @@ func (r *credentialProviderResource) Create(ctx context.Context, req resource.Cr
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.events.record(ctx, "credential_provider", plan.ID.ValueString(), eventActionCreate)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -376,7 +390,7 @@ func (r *credentialProviderResource) Read(ctx context.Context, req resource.Read
 	}
 
 	// Get refreshed credential value from Aembit
-	credentialProvider, err := r.client.GetCredentialProvider(state.ID.ValueString(), nil)
+	credentialProvider, err := cachedGet(r.cache, "credential_provider", state.ID.ValueString(), r.client.GetCredentialProviders, r.client.GetCredentialProvider, func(e aembit.CredentialProviderDTO) aembit.EntityDTO { return e.EntityDTO })
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Aembit Credential Provider",
@@ -419,6 +433,8 @@ func (r *credentialProviderResource) Update(ctx context.Context, req resource.Up
 	// Generate API request body from plan
 	var credential aembit.CredentialProviderDTO = convertCredentialProviderModelToDTO(ctx, plan, &externalID, r.client.Tenant, r.client.StackDomain)
 
+	defer r.cache.invalidate("credential_provider")
+
 	// Update Credential Provider
 	credentialProvider, err := r.client.UpdateCredentialProvider(credential, nil)
 	if err != nil {
@@ -438,6 +454,8 @@ func (r *credentialProviderResource) Update(ctx context.Context, req resource.Up
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.events.record(ctx, "credential_provider", plan.ID.ValueString(), eventActionUpdate)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -450,6 +468,8 @@ func (r *credentialProviderResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	defer r.cache.invalidate("credential_provider")
+
 	// Check if Credential Provider is Active - if it is, disable it first
 	if state.IsActive == types.BoolValue(true) {
 		_, err := r.client.DisableCredentialProvider(state.ID.ValueString(), nil)
@@ -471,6 +491,8 @@ func (r *credentialProviderResource) Delete(ctx context.Context, req resource.De
 		)
 		return
 	}
+
+	r.events.record(ctx, "credential_provider", state.ID.ValueString(), eventActionDelete)
 }
 
 // Imports an existing resource by passing externalId.
@@ -731,17 +753,30 @@ func convertSnowflakeTokenDTOToModel(dto aembit.CredentialProviderDTO) *credenti
 	}
 
 	acctData := strings.Split(snowflake.Subject, ".")
-	keyData := strings.ReplaceAll(snowflake.KeyContent, "\n", "")
-	keyData = strings.Replace(keyData, "-----BEGIN PUBLIC KEY-----", "", 1)
-	keyData = strings.Replace(keyData, "-----END PUBLIC KEY-----", "", 1)
 	value := credentialProviderSnowflakeTokenModel{
 		AccountID:        types.StringValue(acctData[0]),
 		Username:         types.StringValue(acctData[1]),
-		AlertUserCommand: types.StringValue(fmt.Sprintf("ALTER USER %s SET RSA_PUBLIC_KEY='%s'", acctData[1], keyData)),
+		AlertUserCommand: types.StringValue(getSnowflakeAlterUserCommand(acctData[1], snowflake.KeyContent)),
+		PublicKey:        newPEMNull(),
+	}
+	if len(snowflake.KeyContent) > 0 {
+		value.PublicKey = newPEMValue(snowflake.KeyContent)
 	}
 	return &value
 }
 
+// getSnowflakeAlterUserCommand builds the Snowflake statement which assigns the PEM encoded public key to the user.
+// The key is normalized from its decoded PEM block, so that line endings and line wrapping do not change the statement.
+func getSnowflakeAlterUserCommand(username, publicKey string) string {
+	keyData := strings.ReplaceAll(publicKey, "\n", "")
+	keyData = strings.Replace(keyData, "-----BEGIN PUBLIC KEY-----", "", 1)
+	keyData = strings.Replace(keyData, "-----END PUBLIC KEY-----", "", 1)
+	if blocks, err := decodePEMBlocks(publicKey); err == nil {
+		keyData = base64.StdEncoding.EncodeToString(blocks[0].Bytes)
+	}
+	return fmt.Sprintf("ALTER USER %s SET RSA_PUBLIC_KEY='%s'", username, keyData)
+}
+
 // convertOAuthClientCredentialDTOToModel converts the OAuth Client Credential state object into a model ready for terraform processing.
 // Note: Since Aembit vaults the Client Secret and does not return it in the API, the DTO will never contain the stored value.
 func convertOAuthClientCredentialDTOToModel(dto aembit.CredentialProviderDTO, state credentialProviderResourceModel) *credentialProviderOAuthClientCredentialsModel {
@@ -10,6 +10,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// credentialProviderTypes maps the Aembit Credential Provider types to the matching credential provider attribute.
+var credentialProviderTypes = map[string]string{
+	"aembit-access-token":     "aembit_access_token",
+	"apikey":                  "api_key",
+	"aws-sts-oidc":            "aws_sts",
+	"gcp-identity-federation": "google_workload_identity",
+	"signed-jwt":              "snowflake_jwt",
+	"oauth-client-credential": "oauth_client_credentials",
+	"username-password":       "username_password",
+	"vaultClientToken":        "vault_client_token",
+}
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ datasource.DataSource              = &credentialProvidersDataSource{}
@@ -23,7 +35,8 @@ func NewCredentialProvidersDataSource() datasource.DataSource {
 
 // credentialProvidersDataSource is the data source implementation.
 type credentialProvidersDataSource struct {
-	client *aembit.CloudClient
+	client *CloudClient
+	cache  *readCache
 }
 
 // Configure adds the provider configured client to the data source.
@@ -32,17 +45,18 @@ func (d *credentialProvidersDataSource) Configure(_ context.Context, req datasou
 		return
 	}
 
-	client, ok := req.ProviderData.(*aembit.CloudClient)
+	data, ok := req.ProviderData.(*aembitProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *aembit.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.cache = data.cache
 }
 
 // Metadata returns the data source type name.
@@ -171,6 +185,11 @@ func (d *credentialProvidersDataSource) Schema(_ context.Context, _ datasource.S
 									Description: "Snowflake Alter User Command generated for configuration of Snowflake by the Credential Provider.",
 									Computed:    true,
 								},
+								"public_key": schema.StringAttribute{
+									CustomType:  pemType{},
+									Description: "PEM encoded RSA Public Key generated by the Credential Provider, which Snowflake uses to verify the JSON Web Token.",
+									Computed:    true,
+								},
 							},
 						},
 						"oauth_client_credentials": schema.SingleNestedAttribute{
@@ -262,13 +281,27 @@ func (d *credentialProvidersDataSource) Schema(_ context.Context, _ datasource.S
 			},
 		},
 	}
+
+	addEntityFilterAttributes(resp.Schema.Attributes, "credential providers")
+	resp.Schema.Attributes["type"] = entityTypeFilterAttribute(credentialProviderTypes, "credential providers", "aws_sts")
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *credentialProvidersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state credentialProvidersDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newEntityFilter(ctx, state.NameRegex, state.Tags, state.IsActive)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	credentialProviders, err := d.client.GetCredentialProviders(nil)
+	credentialProviders, err := cachedList(d.cache, "credential_provider", d.client.GetCredentialProviders, func(e aembit.CredentialProviderDTO) aembit.EntityDTO { return e.EntityDTO })
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Aembit Credential Providers",
@@ -279,12 +312,15 @@ func (d *credentialProvidersDataSource) Read(ctx context.Context, req datasource
 
 	// Map response body to model
 	for _, credentialProvider := range credentialProviders {
+		if !filter.matches(credentialProvider.EntityDTO) || (len(state.Type.ValueString()) > 0 && credentialProviderTypes[credentialProvider.Type] != state.Type.ValueString()) {
+			continue
+		}
 		credentialProviderState := convertCredentialProviderDTOToModel(ctx, credentialProvider, credentialProviderResourceModel{}, d.client.Tenant, d.client.StackDomain)
 		state.CredentialProviders = append(state.CredentialProviders, credentialProviderState)
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &credentialsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &credentialsEphemeralResource{}
+)
+
+// NewCredentialsEphemeralResource is a helper function to simplify the provider implementation.
+func NewCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &credentialsEphemeralResource{}
+}
+
+// credentialsEphemeralResource is the ephemeral resource implementation.
+type credentialsEphemeralResource struct {
+	edge *aembitEdgeClient
+}
+
+// Configure adds the provider configured Edge client to the ephemeral resource.
+func (r *credentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*aembitProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.edge = data.edge
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *credentialsEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credentials"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *credentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the credentials for multiple Server Workloads from Aembit Edge, sharing a single Trust Provider attestation and connection. The credentials are never persisted to the Terraform plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"targets": schema.ListNestedAttribute{
+				Description: "Server Workloads to retrieve credentials for.",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"target_host": schema.StringAttribute{
+							Description: "Hostname of the Server Workload.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"target_port": schema.Int64Attribute{
+							Description: "Port of the Server Workload.",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"transport_protocol": schema.StringAttribute{
+							Description: "Transport Protocol of the Server Workload. Defaults to `TCP`.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf([]string{"TCP", "UDP"}...),
+							},
+						},
+					},
+				},
+			},
+			"credentials": schema.MapAttribute{
+				Description: "Credentials issued by Aembit, keyed by `host:port` of each Server Workload. Each target must have a distinct `host:port`, whatever its transport protocol.",
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Open retrieves the credentials from Aembit Edge.
+func (r *credentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data credentialsEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.edge == nil || len(r.edge.ClientID) == 0 {
+		resp.Diagnostics.AddError(
+			"Missing Aembit Client ID",
+			"The aembit_credentials ephemeral resource requires the provider to authenticate with a Trust Provider. "+
+				"Set the client_id value in the provider configuration or use the AEMBIT_CLIENT_ID environment variable.",
+		)
+		return
+	}
+
+	targets, diags := newCredentialsTargets(data.Targets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentials, err := getEdgeCredentials(ctx, targets, r.edge.ClientID, r.edge.StackDomain)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Retrieve Aembit Credentials",
+			"Could not retrieve credentials, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	credentialsMap, diags := types.MapValueFrom(ctx, types.StringType, credentials)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Credentials = credentialsMap
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// newCredentialsTargets converts the configured targets to Edge client requests, defaulting to TCP. Targets with the
+// same host:port are rejected, since the credentials are keyed by host:port.
+func newCredentialsTargets(models []credentialsTargetModel) ([]ClientRequestNetwork, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	targets := make([]ClientRequestNetwork, len(models))
+	indexes := make(map[string]int, len(models))
+	for i, target := range models {
+		targets[i] = ClientRequestNetwork{
+			TargetHost:        target.TargetHost.ValueString(),
+			TargetPort:        int32(target.TargetPort.ValueInt64()),
+			TransportProtocol: "TCP",
+		}
+		if len(target.TransportProtocol.ValueString()) > 0 {
+			targets[i].TransportProtocol = target.TransportProtocol.ValueString()
+		}
+
+		key := credentialKey(targets[i])
+		if first, ok := indexes[key]; ok {
+			diags.AddAttributeError(
+				path.Root("targets").AtListIndex(i),
+				"Duplicate Aembit Credentials Target",
+				fmt.Sprintf("Target %d has the same host:port %s as target %d. The credentials are keyed by host:port, so each target must be distinct.", i, key, first),
+			)
+			continue
+		}
+		indexes[key] = i
+	}
+	return targets, diags
+}
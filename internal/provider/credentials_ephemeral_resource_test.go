@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/grpc"
+)
+
+// fakeEdgeCommanderClient returns a numbered credential for each request, unless the request context is done.
+type fakeEdgeCommanderClient struct {
+	EdgeCommanderClient
+	requests []string
+}
+
+func (c *fakeEdgeCommanderClient) GetCredential(ctx context.Context, in *CredentialRequest, _ ...grpc.CallOption) (*CredentialResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	c.requests = append(c.requests, in.ClientRequest)
+	return &CredentialResponse{Credential: fmt.Sprintf("credential-%d", len(c.requests))}, nil
+}
+
+func TestCredentialKey(t *testing.T) {
+	if key := credentialKey(ClientRequestNetwork{TargetHost: "postgres.example.com", TargetPort: 5432, TransportProtocol: "TCP"}); key != "postgres.example.com:5432" {
+		t.Errorf("credentialKey = %s, want postgres.example.com:5432", key)
+	}
+}
+
+func TestNewCredentialsTargets(t *testing.T) {
+	targets, diags := newCredentialsTargets([]credentialsTargetModel{
+		{TargetHost: types.StringValue("postgres.example.com"), TargetPort: types.Int64Value(5432), TransportProtocol: types.StringNull()},
+		{TargetHost: types.StringValue("dns.example.com"), TargetPort: types.Int64Value(53), TransportProtocol: types.StringValue("UDP")},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error %v", diags)
+	}
+	if targets[0].TransportProtocol != "TCP" || targets[1].TransportProtocol != "UDP" || targets[1].TargetPort != 53 {
+		t.Errorf("unexpected targets %+v", targets)
+	}
+
+	_, diags = newCredentialsTargets([]credentialsTargetModel{
+		{TargetHost: types.StringValue("dns.example.com"), TargetPort: types.Int64Value(53), TransportProtocol: types.StringValue("TCP")},
+		{TargetHost: types.StringValue("dns.example.com"), TargetPort: types.Int64Value(53), TransportProtocol: types.StringValue("UDP")},
+	})
+	if !diags.HasError() {
+		t.Error("expected an error for targets with the same host:port")
+	}
+}
+
+func TestRequestAembitCredentials(t *testing.T) {
+	client := &fakeEdgeCommanderClient{}
+	credentials, err := requestAembitCredentials(context.Background(), client, []ClientRequestNetwork{
+		{TargetHost: "postgres.example.com", TargetPort: 5432, TransportProtocol: "TCP"},
+		{TargetHost: "redis.example.com", TargetPort: 6379, TransportProtocol: "TCP"},
+	}, "{}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(credentials) != 2 || credentials["postgres.example.com:5432"] != "credential-1" || credentials["redis.example.com:6379"] != "credential-2" {
+		t.Errorf("unexpected credentials %v", credentials)
+	}
+	if len(client.requests) != 2 {
+		t.Errorf("expected one credential request per target, got %d", len(client.requests))
+	}
+
+	if _, err := requestAembitCredentials(context.Background(), &fakeEdgeCommanderClient{}, []ClientRequestNetwork{
+		{TargetHost: "dns.example.com", TargetPort: 53, TransportProtocol: "TCP"},
+		{TargetHost: "dns.example.com", TargetPort: 53, TransportProtocol: "UDP"},
+	}, "{}"); err == nil {
+		t.Error("expected an error for targets with the same host:port")
+	}
+}
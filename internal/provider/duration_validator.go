@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ validator.String = durationValidator{}
+
+// durationValidator validates that a string is a positive Go duration, e.g. `15m`.
+type durationValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration such as 15m"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v durationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err == nil && duration <= 0 {
+		err = fmt.Errorf("%s is not positive", req.ConfigValue.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("The value must be a positive duration such as 15m: %s.", err.Error()),
+		)
+	}
+}
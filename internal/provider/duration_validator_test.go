@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDurationValidator(t *testing.T) {
+	cases := map[string]bool{
+		"15m":    true,
+		"1h30m":  true,
+		"90s":    true,
+		"0s":     false,
+		"-5m":    false,
+		"15":     false,
+		"minute": false,
+	}
+	for value, valid := range cases {
+		var resp validator.StringResponse
+		durationValidator{}.ValidateString(context.Background(), validator.StringRequest{ConfigValue: types.StringValue(value)}, &resp)
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("durationValidator(%q) returned %v, want valid %v", value, resp.Diagnostics, valid)
+		}
+	}
+}
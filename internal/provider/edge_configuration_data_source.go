@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &edgeConfigurationDataSource{}
+	_ datasource.DataSourceWithConfigure = &edgeConfigurationDataSource{}
+)
+
+// NewEdgeConfigurationDataSource is a helper function to simplify the provider implementation.
+func NewEdgeConfigurationDataSource() datasource.DataSource {
+	return &edgeConfigurationDataSource{}
+}
+
+// edgeConfigurationDataSource is the data source implementation.
+type edgeConfigurationDataSource struct {
+	edge *aembitEdgeClient
+}
+
+// Configure adds the provider configured Edge client to the data source.
+func (d *edgeConfigurationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*aembitProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.edge = data.edge
+}
+
+// Metadata returns the data source type name.
+func (d *edgeConfigurationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_edge_configuration"
+}
+
+// Schema defines the schema for the data source.
+func (d *edgeConfigurationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the configuration which Aembit Edge provides to an Agent, using the Trust Provider attestation of the provider `client_id`.",
+		Attributes: map[string]schema.Attribute{
+			"agent_identity": schema.SingleNestedAttribute{
+				Description: "Identity of the Agent to retrieve the configuration for. If not provided, the Terraform workload attested by the provider is used.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Type of the identity token. Possible values are `gcp_idtoken`, `github_idtoken` and `terraform_idtoken`.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{"gcp_idtoken", "github_idtoken", "terraform_idtoken"}...),
+						},
+					},
+					"token": schema.StringAttribute{
+						Description: "Identity token of the Agent.",
+						Required:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "Version of the Edge configuration.",
+				Computed:    true,
+			},
+			"server_workloads": schema.ListNestedAttribute{
+				Description: "Server Workloads included in the Edge configuration.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Unique identifier of the Server Workload.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the Server Workload.",
+							Computed:    true,
+						},
+						"host": schema.StringAttribute{
+							Description: "Hostname of the Server Workload.",
+							Computed:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "Port of the Server Workload.",
+							Computed:    true,
+						},
+						"app_protocol": schema.StringAttribute{
+							Description: "Application Protocol of the Server Workload.",
+							Computed:    true,
+						},
+						"transport_protocol": schema.StringAttribute{
+							Description: "Transport Protocol of the Server Workload.",
+							Computed:    true,
+						},
+						"tls": schema.BoolAttribute{
+							Description: "TLS indicator of the Server Workload.",
+							Computed:    true,
+						},
+						"tls_verification": schema.StringAttribute{
+							Description: "TLS verification mode of the Server Workload.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"configuration": schema.StringAttribute{
+				Description: "Raw JSON Edge configuration, for attributes which are not exposed individually.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read retrieves the configuration from Aembit Edge.
+func (d *edgeConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state edgeConfigurationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.edge == nil || len(d.edge.ClientID) == 0 {
+		resp.Diagnostics.AddError(
+			"Missing Aembit Client ID",
+			"The aembit_edge_configuration data source requires the provider to authenticate with a Trust Provider. "+
+				"Set the client_id value in the provider configuration or use the AEMBIT_CLIENT_ID environment variable.",
+		)
+		return
+	}
+
+	var agentAssessment string
+	if state.AgentIdentity != nil {
+		var err error
+		if agentAssessment, err = getWorkloadAssessmentForType(state.AgentIdentity.Type.ValueString(), state.AgentIdentity.Token.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Aembit Edge Configuration",
+				"Could not build agent assessment, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	configuration, err := getEdgeConfiguration(ctx, agentAssessment, d.edge.ClientID, d.edge.StackDomain)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Aembit Edge Configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state, err = convertEdgeConfigurationToModel(configuration, state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Aembit Edge Configuration",
+			"Could not decode the Edge configuration, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// decodeEdgeConfiguration decodes the configuration of the Aembit Edge ConfigurationResponse. A configuration which
+// is not a document of this shape is an error, as its Server Workloads cannot be read.
+func decodeEdgeConfiguration(configuration string) (edgeConfigurationJSON, error) {
+	var config edgeConfigurationJSON
+	if len(configuration) == 0 {
+		return config, fmt.Errorf("aembit edge returned an empty configuration")
+	}
+	if err := json.Unmarshal([]byte(configuration), &config); err != nil {
+		return config, fmt.Errorf("the configuration is not a JSON document: %w", err)
+	}
+	if config.Version == nil {
+		return config, fmt.Errorf("the configuration has no version field")
+	}
+	if config.ServerWorkloads == nil {
+		return config, fmt.Errorf("the configuration version %s has no serverWorkloads field", *config.Version)
+	}
+
+	for i, workload := range *config.ServerWorkloads {
+		switch {
+		case len(workload.ExternalID) == 0:
+			return config, fmt.Errorf("server workload %d of the configuration has no externalId", i)
+		case len(workload.ServiceEndpoint.Host) == 0 || workload.ServiceEndpoint.Port == 0:
+			return config, fmt.Errorf("server workload %s of the configuration has no serviceEndpoint host and port", workload.ExternalID)
+		}
+	}
+	return config, nil
+}
+
+func convertEdgeConfigurationToModel(configuration string, state edgeConfigurationDataSourceModel) (edgeConfigurationDataSourceModel, error) {
+	config, err := decodeEdgeConfiguration(configuration)
+	if err != nil {
+		return state, err
+	}
+
+	state.Configuration = types.StringValue(configuration)
+	state.Version = types.StringValue(*config.Version)
+	state.ServerWorkloads = make([]edgeConfigurationServerWorkloadModel, len(*config.ServerWorkloads))
+	for i, workload := range *config.ServerWorkloads {
+		state.ServerWorkloads[i] = edgeConfigurationServerWorkloadModel{
+			ID:                types.StringValue(workload.ExternalID),
+			Name:              types.StringValue(workload.Name),
+			Host:              types.StringValue(workload.ServiceEndpoint.Host),
+			Port:              types.Int64Value(int64(workload.ServiceEndpoint.Port)),
+			AppProtocol:       types.StringValue(workload.ServiceEndpoint.AppProtocol),
+			TransportProtocol: types.StringValue(workload.ServiceEndpoint.TransportProtocol),
+			TLS:               types.BoolValue(workload.ServiceEndpoint.TLS),
+			TLSVerification:   types.StringValue(workload.ServiceEndpoint.TLSVerification),
+		}
+	}
+	return state, nil
+}
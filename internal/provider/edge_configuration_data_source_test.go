@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestConvertEdgeConfigurationToModel(t *testing.T) {
+	configuration := `{"version":"1.0.0","serverWorkloads":[{"externalId":"a1b2","name":"postgres","isActive":true,"serviceEndpoint":{"host":"postgres.example.com","port":5432,"appProtocol":"Postgres","transportProtocol":"TCP","tls":true,"tlsVerification":"full"}}],"unexposed":{"key":"value"}}`
+
+	model, err := convertEdgeConfigurationToModel(configuration, edgeConfigurationDataSourceModel{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if model.Version.ValueString() != "1.0.0" || model.Configuration.ValueString() != configuration {
+		t.Errorf("unexpected version %s or configuration %s", model.Version, model.Configuration)
+	}
+	if len(model.ServerWorkloads) != 1 {
+		t.Fatalf("expected 1 server workload, got %d", len(model.ServerWorkloads))
+	}
+	workload := model.ServerWorkloads[0]
+	if workload.ID.ValueString() != "a1b2" || workload.Host.ValueString() != "postgres.example.com" || workload.Port.ValueInt64() != 5432 || !workload.TLS.ValueBool() {
+		t.Errorf("unexpected server workload %+v", workload)
+	}
+
+	if model, err = convertEdgeConfigurationToModel(`{"version":"1.0.0","serverWorkloads":[]}`, edgeConfigurationDataSourceModel{}); err != nil || len(model.ServerWorkloads) != 0 {
+		t.Errorf("expected a configuration without Server Workloads, got %d (%v)", len(model.ServerWorkloads), err)
+	}
+}
+
+func TestConvertEdgeConfigurationToModelUnknown(t *testing.T) {
+	cases := map[string]string{
+		"empty":                "",
+		"not json":             "not json",
+		"array":                `[{"version":"1.0.0"}]`,
+		"other document":       `{"policies":[]}`,
+		"no server workloads":  `{"version":"1.0.0"}`,
+		"flat server workload": `{"version":"1.0.0","serverWorkloads":[{"externalId":"a1b2","host":"postgres.example.com","port":5432}]}`,
+		"no identifier":        `{"version":"1.0.0","serverWorkloads":[{"serviceEndpoint":{"host":"postgres.example.com","port":5432}}]}`,
+	}
+	for name, configuration := range cases {
+		if model, err := convertEdgeConfigurationToModel(configuration, edgeConfigurationDataSourceModel{}); err == nil {
+			t.Errorf("%s: expected an error, got %+v", name, model)
+		}
+	}
+}
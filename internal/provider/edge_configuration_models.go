@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"aembit.io/aembit"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// edgeConfigurationDataSourceModel maps the data source schema.
+type edgeConfigurationDataSourceModel struct {
+	AgentIdentity   *edgeIdentityModel                     `tfsdk:"agent_identity"`
+	Version         types.String                           `tfsdk:"version"`
+	ServerWorkloads []edgeConfigurationServerWorkloadModel `tfsdk:"server_workloads"`
+	Configuration   types.String                           `tfsdk:"configuration"`
+}
+
+// edgeConfigurationServerWorkloadModel maps a Server Workload of the Edge configuration.
+type edgeConfigurationServerWorkloadModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Host              types.String `tfsdk:"host"`
+	Port              types.Int64  `tfsdk:"port"`
+	AppProtocol       types.String `tfsdk:"app_protocol"`
+	TransportProtocol types.String `tfsdk:"transport_protocol"`
+	TLS               types.Bool   `tfsdk:"tls"`
+	TLSVerification   types.String `tfsdk:"tls_verification"`
+}
+
+// edgeConfigurationJSON is the configuration document of the Aembit Edge ConfigurationResponse. Its Server Workloads
+// are those of the Aembit API. The fields are pointers so that a document without them is rejected rather than read as
+// an empty configuration.
+type edgeConfigurationJSON struct {
+	Version         *string                             `json:"version"`
+	ServerWorkloads *[]aembit.ServerWorkloadExternalDTO `json:"serverWorkloads"`
+}
@@ -0,0 +1,11 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// edgeIdentityModel maps an identity token of a workload presented to Aembit Edge.
+type edgeIdentityModel struct {
+	Type  types.String `tfsdk:"type"`
+	Token types.String `tfsdk:"token"`
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+)
+
+// edgeSession is a connection to Aembit Edge on behalf of the attested Terraform workload, allowing
+// multiple requests to share a single Trust Provider attestation.
+type edgeSession struct {
+	client             EdgeCommanderClient
+	conn               *grpc.ClientConn
+	token              string
+	workloadAssessment string
+}
+
+// newEdgeSession attests the Terraform workload identified by clientId and connects to Aembit Edge.
+// The caller must Close the session.
+func newEdgeSession(clientId, stackDomain string) (*edgeSession, error) {
+	idToken, err := getIdentityToken(clientId, stackDomain)
+	if err != nil {
+		return nil, err
+	}
+	aembitToken, err := getAembitToken(clientId, stackDomain, idToken)
+	if err != nil {
+		return nil, err
+	}
+	workloadAssessment, err := getWorkloadAssessment(clientId, idToken)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := dialEdgeCommander(clientId, stackDomain, aembitToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &edgeSession{
+		client:             NewEdgeCommanderClient(conn),
+		conn:               conn,
+		token:              aembitToken,
+		workloadAssessment: workloadAssessment,
+	}, nil
+}
+
+func (s *edgeSession) Close() error {
+	return s.conn.Close()
+}
+
+// getEdgeCredential retrieves the credential for the Server Workload at targetHost:targetPort.
+func getEdgeCredential(ctx context.Context, targetHost string, targetPort int32, transportProtocol, clientId, stackDomain string) (string, error) {
+	session, err := newEdgeSession(clientId, stackDomain)
+	if err != nil {
+		return "", err
+	}
+	defer session.Close()
+
+	return requestAembitCredential(ctx, session.client, ClientRequestNetwork{TargetHost: targetHost, TargetPort: targetPort, TransportProtocol: transportProtocol}, session.workloadAssessment)
+}
+
+// getEdgeCredentials retrieves the credentials for each of the targets over a single Edge session.
+// The credentials are keyed by credentialKey, so the targets must have distinct keys.
+func getEdgeCredentials(ctx context.Context, targets []ClientRequestNetwork, clientId, stackDomain string) (map[string]string, error) {
+	session, err := newEdgeSession(clientId, stackDomain)
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+
+	return requestAembitCredentials(ctx, session.client, targets, session.workloadAssessment)
+}
+
+// requestAembitCredentials requests the credential of each of the targets, keyed by credentialKey.
+func requestAembitCredentials(ctx context.Context, aembitClient EdgeCommanderClient, targets []ClientRequestNetwork, workloadAssessment string) (map[string]string, error) {
+	var err error
+
+	credentials := make(map[string]string, len(targets))
+	for _, target := range targets {
+		key := credentialKey(target)
+		if _, ok := credentials[key]; ok {
+			return nil, fmt.Errorf("duplicate target %s", key)
+		}
+		if credentials[key], err = requestAembitCredential(ctx, aembitClient, target, workloadAssessment); err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	return credentials, nil
+}
+
+// credentialKey returns the host:port key of the target credential.
+func credentialKey(target ClientRequestNetwork) string {
+	return fmt.Sprintf("%s:%d", target.TargetHost, target.TargetPort)
+}
+
+// getEdgeCertificate requests a certificate chain for the PEM encoded CSR, to terminate TLS connections to the target.
+func getEdgeCertificate(ctx context.Context, target ClientRequestNetwork, csrPEM, clientId, stackDomain string) (string, error) {
+	session, err := newEdgeSession(clientId, stackDomain)
+	if err != nil {
+		return "", err
+	}
+	defer session.Close()
+
+	clientRequest, err := getClientRequest(target.TargetHost, target.TargetPort, target.TransportProtocol)
+	if err != nil {
+		return "", err
+	}
+
+	certResponse, err := session.client.GetCertificate(ctx, &CertificateRequest{
+		ClientRequest:             clientRequest,
+		AgentAssessment:           session.workloadAssessment,
+		WorkloadAssessment:        session.workloadAssessment,
+		CertificateSigningRequest: csrPEM,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return certResponse.CertificateChain, nil
+}
+
+// getEdgeConfiguration retrieves the configuration Aembit Edge provides for an Agent. The agentAssessment describes
+// the Agent; if empty, the attested Terraform workload itself is used.
+func getEdgeConfiguration(ctx context.Context, agentAssessment, clientId, stackDomain string) (string, error) {
+	session, err := newEdgeSession(clientId, stackDomain)
+	if err != nil {
+		return "", err
+	}
+	defer session.Close()
+
+	if len(agentAssessment) == 0 {
+		agentAssessment = session.workloadAssessment
+	}
+
+	configResponse, err := session.client.GetConfiguration(ctx, &ConfigurationRequest{
+		AgentAssessment: agentAssessment,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return configResponse.Configuration, nil
+}
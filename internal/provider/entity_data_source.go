@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"aembit.io/aembit"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// entitySchema builds the schema of a singular data source from the list attribute of the matching plural data source,
+// so that both data sources return the same entity attributes. The entity is looked up by id or, if byName is set, by name.
+func entitySchema(ctx context.Context, plural datasource.DataSource, listAttribute, description string, byName bool) (schema.Schema, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var pluralResp datasource.SchemaResponse
+	plural.Schema(ctx, datasource.SchemaRequest{}, &pluralResp)
+	if pluralResp.Diagnostics.HasError() {
+		return schema.Schema{}, pluralResp.Diagnostics
+	}
+
+	list, ok := pluralResp.Schema.Attributes[listAttribute].(schema.ListNestedAttribute)
+	if !ok {
+		diags.AddError(
+			"Unexpected Data Source Schema",
+			fmt.Sprintf("Expected %s to be a list nested attribute. Please report this issue to the provider developers.", listAttribute),
+		)
+		return schema.Schema{}, diags
+	}
+
+	attributes := make(map[string]schema.Attribute, len(list.NestedObject.Attributes))
+	for name, attribute := range list.NestedObject.Attributes {
+		attributes[name] = attribute
+	}
+
+	if !byName {
+		attributes["id"] = schema.StringAttribute{
+			Description: attributes["id"].GetDescription(),
+			Required:    true,
+		}
+		return schema.Schema{Description: description, Attributes: attributes}, diags
+	}
+
+	lookupValidators := []validator.String{
+		stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		stringvalidator.LengthAtLeast(1),
+	}
+	attributes["id"] = schema.StringAttribute{
+		Description: attributes["id"].GetDescription() + " Exactly one of `id` or `name` must be specified.",
+		Optional:    true,
+		Computed:    true,
+		Validators:  lookupValidators,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: attributes["name"].GetDescription() + " Exactly one of `id` or `name` must be specified.",
+		Optional:    true,
+		Computed:    true,
+		Validators:  lookupValidators,
+	}
+	return schema.Schema{Description: description, Attributes: attributes}, diags
+}
+
+// getEntityLookup returns the id and name configured on a singular data source. The name is empty for data sources which only look up by id.
+func getEntityLookup(ctx context.Context, config tfsdk.Config) (string, string, diag.Diagnostics) {
+	var id, name types.String
+	diags := config.GetAttribute(ctx, path.Root("id"), &id)
+	if _, ok := config.Schema.GetAttributes()["name"]; ok {
+		diags.Append(config.GetAttribute(ctx, path.Root("name"), &name)...)
+	}
+	return id.ValueString(), name.ValueString(), diags
+}
+
+// findEntity returns the single entity matching the id or name, or an error if there is no match or the name is ambiguous.
+func findEntity[T any](items []T, id, name, entityType string, entity func(T) aembit.EntityDTO) (T, error) {
+	var found []T
+	for _, item := range items {
+		if (len(id) > 0 && entity(item).ExternalID == id) || (len(id) == 0 && entity(item).Name == name) {
+			found = append(found, item)
+		}
+	}
+
+	var none T
+	lookup := fmt.Sprintf("name %q", name)
+	if len(id) > 0 {
+		lookup = fmt.Sprintf("id %q", id)
+	}
+	switch len(found) {
+	case 0:
+		return none, fmt.Errorf("no %s found with %s", entityType, lookup)
+	case 1:
+		return found[0], nil
+	default:
+		return none, fmt.Errorf("%d %ss found with %s, expected exactly one", len(found), entityType, lookup)
+	}
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"testing"
+
+	"aembit.io/aembit"
+)
+
+func TestFindEntity(t *testing.T) {
+	entities := []aembit.EntityDTO{
+		{ExternalID: "1", Name: "first"},
+		{ExternalID: "2", Name: "duplicate"},
+		{ExternalID: "3", Name: "duplicate"},
+	}
+	entity := func(e aembit.EntityDTO) aembit.EntityDTO { return e }
+
+	if found, err := findEntity(entities, "2", "", "server workload", entity); err != nil || found.ExternalID != "2" {
+		t.Errorf("expected lookup by id to find 2, got %v (%v)", found, err)
+	}
+	if found, err := findEntity(entities, "", "first", "server workload", entity); err != nil || found.ExternalID != "1" {
+		t.Errorf("expected lookup by name to find 1, got %v (%v)", found, err)
+	}
+	if _, err := findEntity(entities, "", "duplicate", "server workload", entity); err == nil {
+		t.Error("expected an error for an ambiguous name")
+	}
+	if _, err := findEntity(entities, "", "missing", "server workload", entity); err == nil {
+		t.Error("expected an error for a missing name")
+	}
+	if _, err := findEntity(entities, "4", "", "server workload", entity); err == nil {
+		t.Error("expected an error for a missing id")
+	}
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"aembit.io/aembit"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// addEntityFilterAttributes adds the optional filter arguments shared by the plural data sources.
+// The Aembit API does not support filtering, so the filters are applied by the provider.
+func addEntityFilterAttributes(attributes map[string]schema.Attribute, entityType string) {
+	attributes["name_regex"] = schema.StringAttribute{
+		Description: fmt.Sprintf("Regular expression which the name of the %s must match.", entityType),
+		Optional:    true,
+	}
+	attributes["tags"] = schema.MapAttribute{
+		Description: fmt.Sprintf("Tags which the %s must all have, with the same values.", entityType),
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+	attributes["is_active"] = schema.BoolAttribute{
+		Description: fmt.Sprintf("Active/Inactive status which the %s must have.", entityType),
+		Optional:    true,
+	}
+}
+
+// entityTypeFilterAttribute returns the optional type argument of a plural data source. The accepted values are the
+// attribute names of entityTypes, which maps the Aembit API types to the type attributes of the entity.
+func entityTypeFilterAttribute(entityTypes map[string]string, entityType, example string) schema.StringAttribute {
+	names := make([]string, 0, len(entityTypes))
+	for _, name := range entityTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("Type which the %s must have, named after the type attribute, e.g. `%s`.", entityType, example),
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(names...),
+		},
+	}
+}
+
+// entityFilter matches Aembit entities against the filter arguments of a plural data source.
+type entityFilter struct {
+	nameRegex *regexp.Regexp
+	tags      map[string]string
+	isActive  *bool
+}
+
+// newEntityFilter builds the filter from the configured name_regex, tags and is_active arguments. Null arguments match every entity.
+func newEntityFilter(ctx context.Context, nameRegex types.String, tags types.Map, isActive types.Bool) (entityFilter, diag.Diagnostics) {
+	var filter entityFilter
+	var diags diag.Diagnostics
+
+	if len(nameRegex.ValueString()) > 0 {
+		regex, err := regexp.Compile(nameRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return filter, diags
+		}
+		filter.nameRegex = regex
+	}
+	if !tags.IsNull() && !tags.IsUnknown() {
+		diags.Append(tags.ElementsAs(ctx, &filter.tags, false)...)
+	}
+	if !isActive.IsNull() && !isActive.IsUnknown() {
+		active := isActive.ValueBool()
+		filter.isActive = &active
+	}
+	return filter, diags
+}
+
+// matches returns true if the entity matches all of the filter arguments.
+func (f entityFilter) matches(entity aembit.EntityDTO) bool {
+	if f.nameRegex != nil && !f.nameRegex.MatchString(entity.Name) {
+		return false
+	}
+	if f.isActive != nil && *f.isActive != entity.IsActive {
+		return false
+	}
+	for key, value := range f.tags {
+		found := false
+		for _, tag := range entity.Tags {
+			if tag.Key == key && tag.Value == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"aembit.io/aembit"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEntityFilter(t *testing.T) {
+	ctx := context.Background()
+	entity := aembit.EntityDTO{
+		Name:     "prod-postgres",
+		IsActive: true,
+		Tags:     []aembit.TagDTO{{Key: "env", Value: "prod"}, {Key: "team", Value: "data"}},
+	}
+	tags := func(values map[string]string) types.Map {
+		elements := make(map[string]attr.Value, len(values))
+		for key, value := range values {
+			elements[key] = types.StringValue(value)
+		}
+		return types.MapValueMust(types.StringType, elements)
+	}
+
+	cases := []struct {
+		nameRegex types.String
+		tags      types.Map
+		isActive  types.Bool
+		want      bool
+	}{
+		{nameRegex: types.StringNull(), tags: types.MapNull(types.StringType), isActive: types.BoolNull(), want: true},
+		{nameRegex: types.StringValue("^prod-"), tags: types.MapNull(types.StringType), isActive: types.BoolNull(), want: true},
+		{nameRegex: types.StringValue("^dev-"), tags: types.MapNull(types.StringType), isActive: types.BoolNull(), want: false},
+		{nameRegex: types.StringNull(), tags: tags(map[string]string{"env": "prod", "team": "data"}), isActive: types.BoolNull(), want: true},
+		{nameRegex: types.StringNull(), tags: tags(map[string]string{"env": "prod", "team": "web"}), isActive: types.BoolNull(), want: false},
+		{nameRegex: types.StringNull(), tags: tags(map[string]string{"owner": "data"}), isActive: types.BoolNull(), want: false},
+		{nameRegex: types.StringNull(), tags: types.MapNull(types.StringType), isActive: types.BoolValue(true), want: true},
+		{nameRegex: types.StringNull(), tags: types.MapNull(types.StringType), isActive: types.BoolValue(false), want: false},
+	}
+	for i, c := range cases {
+		filter, diags := newEntityFilter(ctx, c.nameRegex, c.tags, c.isActive)
+		if diags.HasError() {
+			t.Fatalf("case %d: unexpected error: %v", i, diags)
+		}
+		if got := filter.matches(entity); got != c.want {
+			t.Errorf("case %d: matches = %v, want %v", i, got, c.want)
+		}
+	}
+
+	if _, diags := newEntityFilter(ctx, types.StringValue("("), types.MapNull(types.StringType), types.BoolNull()); !diags.HasError() {
+		t.Error("expected an error for an invalid name_regex")
+	}
+}
+
+func TestEntityTypeFilterAttribute(t *testing.T) {
+	ctx := context.Background()
+	validate := func(value string) bool {
+		var resp validator.StringResponse
+		for _, v := range entityTypeFilterAttribute(credentialProviderTypes, "credential providers", "aws_sts").Validators {
+			v.ValidateString(ctx, validator.StringRequest{ConfigValue: types.StringValue(value)}, &resp)
+		}
+		return !resp.Diagnostics.HasError()
+	}
+
+	for apiType, attribute := range credentialProviderTypes {
+		if !validate(attribute) {
+			t.Errorf("expected the %s type of %s to be accepted", attribute, apiType)
+		}
+	}
+	if validate("unknown") {
+		t.Error("expected an unknown type to be rejected")
+	}
+}
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Terraform change event actions.
+const (
+	eventActionCreate = "create"
+	eventActionUpdate = "update"
+	eventActionDelete = "delete"
+)
+
+// terraformEvent describes a change made by Terraform to an Aembit entity.
+type terraformEvent struct {
+	Meta      terraformEventMeta      `json:"meta"`
+	Entity    terraformEventEntity    `json:"entity"`
+	Terraform terraformEventExecution `json:"terraform"`
+}
+
+type terraformEventMeta struct {
+	Timestamp string `json:"timestamp"`
+	EventType string `json:"eventType"`
+	Severity  string `json:"severity"`
+}
+
+type terraformEventEntity struct {
+	Type       string `json:"type"`
+	ExternalID string `json:"externalId"`
+	Action     string `json:"action"`
+}
+
+// terraformEventExecution identifies the Terraform run, from the Terraform CLI and HCP Terraform (TFC) run environment.
+type terraformEventExecution struct {
+	ProviderVersion string `json:"providerVersion"`
+	Workspace       string `json:"workspace,omitempty"`
+	RunID           string `json:"runId,omitempty"`
+	WorkspaceName   string `json:"workspaceName,omitempty"`
+	WorkspaceSlug   string `json:"workspaceSlug,omitempty"`
+	ProjectName     string `json:"projectName,omitempty"`
+	GitBranch       string `json:"gitBranch,omitempty"`
+	GitCommitSha    string `json:"gitCommitSha,omitempty"`
+}
+
+// terraformEvents is the batch of events reported with ReportEvents.
+type terraformEvents struct {
+	Events []terraformEvent `json:"events"`
+	Count  int              `json:"count"`
+}
+
+// eventRecorder buffers the Terraform change events of a provider, and reports them through Aembit Edge in a single
+// ReportEvents request once Terraform is done with the provider. Each configured provider with event reporting enabled
+// has its own recorder. A nil recorder does not report events.
+//
+// Terraform stops the provider process shortly after it is done with it, so the Edge session is opened with the first
+// event, leaving only the ReportEvents request for the end of the run.
+type eventRecorder struct {
+	mutex       sync.Mutex
+	version     string
+	events      []terraformEvent
+	session     *edgeSession
+	openSession func() (*edgeSession, error)
+}
+
+// eventRecorders are the recorders of the configured providers, which FlushEvents reports the events of.
+var eventRecorders struct {
+	mutex     sync.Mutex
+	recorders []*eventRecorder
+}
+
+// newEventRecorder returns a recorder which reports events on behalf of the Terraform workload attested by edge.
+func newEventRecorder(edge *aembitEdgeClient, version string) *eventRecorder {
+	recorder := &eventRecorder{
+		version: version,
+		openSession: func() (*edgeSession, error) {
+			return newEdgeSession(edge.ClientID, edge.StackDomain)
+		},
+	}
+
+	eventRecorders.mutex.Lock()
+	defer eventRecorders.mutex.Unlock()
+	eventRecorders.recorders = append(eventRecorders.recorders, recorder)
+	return recorder
+}
+
+// record buffers a successful change to an Aembit entity. Events are informational, so a failure to open the Edge
+// session is logged rather than failing the change, and the session is opened again when the events are reported.
+func (r *eventRecorder) record(ctx context.Context, entityType, externalID, action string) {
+	if r == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.events = append(r.events, newTerraformEvent(entityType, externalID, action, r.version, time.Now()))
+	if r.session == nil {
+		var err error
+		if r.session, err = r.openSession(); err != nil {
+			tflog.Warn(ctx, "Failed to connect to Aembit Edge to report events", map[string]any{
+				"error": err.Error(),
+			})
+		}
+	}
+}
+
+// flush reports the buffered events in a single ReportEvents request and closes the Edge session of the recorder.
+// The session is opened again if its Aembit token has expired, or if Aembit Edge no longer accepts it.
+func (r *eventRecorder) flush(ctx context.Context) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	defer r.close()
+
+	if len(r.events) == 0 {
+		return nil
+	}
+	events, err := json.Marshal(terraformEvents{Events: r.events, Count: len(r.events)})
+	if err != nil {
+		return err
+	}
+
+	if r.session != nil && !isTokenValid(r.session.token) {
+		r.close()
+	}
+	for attempt := 0; ; attempt++ {
+		if r.session == nil {
+			if r.session, err = r.openSession(); err != nil {
+				return err
+			}
+		}
+
+		_, err = r.session.client.ReportEvents(ctx, &EventRequests{Events: string(events)})
+		if status.Code(err) != codes.Unauthenticated || attempt > 0 {
+			break
+		}
+
+		// The Aembit token was rejected, so attest the Terraform workload again for a new one
+		AEMBIT_TOKEN = ""
+		r.close()
+	}
+	if err != nil {
+		return err
+	}
+
+	r.events = nil
+	return nil
+}
+
+// close closes the Edge session of the recorder, if it is open.
+func (r *eventRecorder) close() {
+	if r.session != nil {
+		_ = r.session.Close()
+		r.session = nil
+	}
+}
+
+// FlushEvents reports the buffered Terraform change events of every configured provider, and closes their Edge
+// sessions. It is called once the provider server has stopped.
+func FlushEvents(ctx context.Context) error {
+	eventRecorders.mutex.Lock()
+	defer eventRecorders.mutex.Unlock()
+
+	var errs []error
+	for _, recorder := range eventRecorders.recorders {
+		if err := recorder.flush(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// newTerraformEvent describes a change to an Aembit entity, with the details of the Terraform run from the environment.
+func newTerraformEvent(entityType, externalID, action, version string, now time.Time) terraformEvent {
+	return terraformEvent{
+		Meta: terraformEventMeta{
+			Timestamp: now.UTC().Format(time.RFC3339),
+			EventType: "Terraform.Change",
+			Severity:  "Info",
+		},
+		Entity: terraformEventEntity{
+			Type:       entityType,
+			ExternalID: externalID,
+			Action:     action,
+		},
+		Terraform: terraformEventExecution{
+			ProviderVersion: version,
+			Workspace:       os.Getenv("TF_WORKSPACE"),
+			RunID:           os.Getenv("TFC_RUN_ID"),
+			WorkspaceName:   os.Getenv("TFC_WORKSPACE_NAME"),
+			WorkspaceSlug:   os.Getenv("TFC_WORKSPACE_SLUG"),
+			ProjectName:     os.Getenv("TFC_PROJECT_NAME"),
+			GitBranch:       os.Getenv("TFC_CONFIGURATION_VERSION_GIT_BRANCH"),
+			GitCommitSha:    os.Getenv("TFC_CONFIGURATION_VERSION_GIT_COMMIT_SHA"),
+		},
+	}
+}
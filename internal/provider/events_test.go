@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// fakeEventClient records the reported batches of events, or fails to report them.
+type fakeEventClient struct {
+	EdgeCommanderClient
+	batches []terraformEvents
+	errs    []error
+}
+
+func (c *fakeEventClient) ReportEvents(_ context.Context, in *EventRequests, _ ...grpc.CallOption) (*EventResponse, error) {
+	if len(c.errs) > 0 {
+		err := c.errs[0]
+		c.errs = c.errs[1:]
+		return nil, err
+	}
+	var batch terraformEvents
+	if err := json.Unmarshal([]byte(in.Events), &batch); err != nil {
+		return nil, err
+	}
+	c.batches = append(c.batches, batch)
+	return &EventResponse{}, nil
+}
+
+// newTestEventRecorder returns a recorder whose Edge sessions use the client, and whose Aembit token expires at
+// expiry. The number of sessions opened is counted in sessions.
+func newTestEventRecorder(t *testing.T, client EdgeCommanderClient, expiry time.Time, sessions *int) *eventRecorder {
+	return &eventRecorder{
+		version: "test",
+		openSession: func() (*edgeSession, error) {
+			conn, err := grpc.NewClient("passthrough:///edge", grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			*sessions++
+			return &edgeSession{client: client, conn: conn, token: newTestJWT(expiry)}, nil
+		},
+	}
+}
+
+// newTestJWT returns an unsigned JWT which expires at expiry.
+func newTestJWT(expiry time.Time) string {
+	payload, _ := json.Marshal(map[string]any{"exp": expiry.Unix()})
+	return "e30." + base64.RawURLEncoding.EncodeToString(payload) + ".sig"
+}
+
+func TestNewTerraformEvent(t *testing.T) {
+	t.Setenv("TFC_RUN_ID", "run-abc123")
+	t.Setenv("TFC_WORKSPACE_NAME", "production")
+
+	event := newTerraformEvent("access_policy", "a1b2", eventActionCreate, "test", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	if event.Entity.Type != "access_policy" || event.Entity.ExternalID != "a1b2" || event.Entity.Action != eventActionCreate {
+		t.Errorf("unexpected entity %+v", event.Entity)
+	}
+	if event.Terraform.RunID != "run-abc123" || event.Terraform.WorkspaceName != "production" || event.Terraform.ProviderVersion != "test" {
+		t.Errorf("unexpected run metadata %+v", event.Terraform)
+	}
+	if event.Meta.Timestamp != "2024-01-02T03:04:05Z" {
+		t.Errorf("unexpected timestamp %s", event.Meta.Timestamp)
+	}
+}
+
+func TestEventRecorder(t *testing.T) {
+	ctx := context.Background()
+
+	// Events are not reported when reporting is disabled
+	var disabled *eventRecorder
+	disabled.record(ctx, "access_policy", "ignored", eventActionCreate)
+
+	// Events are buffered, with the session opened by the first event, and reported in a single request
+	client := &fakeEventClient{}
+	sessions := 0
+	recorder := newTestEventRecorder(t, client, time.Now().Add(time.Hour), &sessions)
+	recorder.record(ctx, "access_policy", "a1b2", eventActionCreate)
+	recorder.record(ctx, "server_workload", "c3d4", eventActionDelete)
+	if len(client.batches) != 0 || sessions != 1 {
+		t.Fatalf("expected no reported events over 1 session before the flush, got %d over %d", len(client.batches), sessions)
+	}
+	if err := recorder.flush(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(client.batches) != 1 || client.batches[0].Count != 2 || len(client.batches[0].Events) != 2 {
+		t.Fatalf("expected a single batch of 2 events, got %+v", client.batches)
+	}
+	if event := client.batches[0].Events[1].Entity; event.Type != "server_workload" || event.ExternalID != "c3d4" || event.Action != eventActionDelete {
+		t.Errorf("unexpected entity %+v", event)
+	}
+	if sessions != 1 || recorder.session != nil {
+		t.Errorf("expected the session to be reused and closed, got %d sessions", sessions)
+	}
+
+	// Nothing is reported once the events have been flushed
+	if err := recorder.flush(ctx); err != nil || len(client.batches) != 1 {
+		t.Errorf("expected no further report, got %d batches (%v)", len(client.batches), err)
+	}
+}
+
+func TestEventRecorderRefresh(t *testing.T) {
+	ctx := context.Background()
+
+	// A session whose Aembit token has expired is opened again before reporting
+	client := &fakeEventClient{}
+	sessions := 0
+	recorder := newTestEventRecorder(t, client, time.Now().Add(-time.Minute), &sessions)
+	recorder.record(ctx, "access_policy", "a1b2", eventActionCreate)
+	if err := recorder.flush(ctx); err != nil || sessions != 2 || len(client.batches) != 1 {
+		t.Errorf("expected the events reported over a new session, got %d batches over %d sessions (%v)", len(client.batches), sessions, err)
+	}
+
+	// A rejected Aembit token is discarded, and the events reported again over a new session
+	AEMBIT_TOKEN = "rejected"
+	t.Cleanup(func() { AEMBIT_TOKEN = "" })
+	client = &fakeEventClient{errs: []error{status.Error(codes.Unauthenticated, "expired")}}
+	sessions = 0
+	recorder = newTestEventRecorder(t, client, time.Now().Add(time.Hour), &sessions)
+	recorder.record(ctx, "access_policy", "a1b2", eventActionCreate)
+	if err := recorder.flush(ctx); err != nil || sessions != 2 || len(client.batches) != 1 || len(AEMBIT_TOKEN) != 0 {
+		t.Errorf("expected the events reported over a new session, got %d batches over %d sessions (%v)", len(client.batches), sessions, err)
+	}
+
+	// Any other failure is returned, and the events are kept
+	client = &fakeEventClient{errs: []error{errors.New("unavailable")}}
+	recorder = newTestEventRecorder(t, client, time.Now().Add(time.Hour), &sessions)
+	recorder.record(ctx, "access_policy", "a1b2", eventActionCreate)
+	if err := recorder.flush(ctx); err == nil || len(recorder.events) != 1 || recorder.session != nil {
+		t.Errorf("expected the report error with the events kept and the session closed, got %v", err)
+	}
+}
+
+func TestFlushEvents(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() { eventRecorders.recorders = nil })
+
+	recorder := newEventRecorder(&aembitEdgeClient{}, "test")
+	client := &fakeEventClient{}
+	sessions := 0
+	recorder.openSession = newTestEventRecorder(t, client, time.Now().Add(time.Hour), &sessions).openSession
+	recorder.record(ctx, "access_policy", "a1b2", eventActionCreate)
+
+	if err := FlushEvents(ctx); err != nil || len(client.batches) != 1 {
+		t.Errorf("expected the events of the recorder reported, got %d batches (%v)", len(client.batches), err)
+	}
+}
@@ -5,125 +5,65 @@ import (
 	"fmt"
 
 	"aembit.io/aembit"
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &integrationsDataSource{}
-	_ datasource.DataSourceWithConfigure = &integrationsDataSource{}
+	_ datasource.DataSource              = &integrationDataSource{}
+	_ datasource.DataSourceWithConfigure = &integrationDataSource{}
 )
 
-// NewIntegrationsDataSource is a helper function to simplify the provider implementation.
-func NewIntegrationsDataSource() datasource.DataSource {
-	return &integrationsDataSource{}
+// NewIntegrationDataSource is a helper function to simplify the provider implementation.
+func NewIntegrationDataSource() datasource.DataSource {
+	return &integrationDataSource{}
 }
 
-// integrationsDataSource is the data source implementation.
-type integrationsDataSource struct {
-	client *aembit.CloudClient
+// integrationDataSource is the data source implementation.
+type integrationDataSource struct {
+	client *CloudClient
+	cache  *readCache
 }
 
 // Configure adds the provider configured client to the data source.
-func (d *integrationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+func (d *integrationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*aembit.CloudClient)
+	data, ok := req.ProviderData.(*aembitProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *aembit.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.cache = data.cache
 }
 
 // Metadata returns the data source type name.
-func (d *integrationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_integrations"
+func (d *integrationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
 }
 
-// Schema defines the schema for the resource.
-func (d *integrationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description: "Manages an integration.",
-		Attributes: map[string]schema.Attribute{
-			"integrations": schema.ListNestedAttribute{
-				Description: "List of integrations.",
-				Computed:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						// ID field is required for Terraform Framework acceptance testing.
-						"id": schema.StringAttribute{
-							Description: "Unique identifier of the integration.",
-							Computed:    true,
-						},
-						"name": schema.StringAttribute{
-							Description: "User-provided name of the integration.",
-							Computed:    true,
-						},
-						"description": schema.StringAttribute{
-							Description: "User-provided description of the integration.",
-							Computed:    true,
-						},
-						"is_active": schema.BoolAttribute{
-							Description: "Active/Inactive status of the integration.",
-							Computed:    true,
-						},
-						"tags": schema.MapAttribute{
-							Description: "Tags are key-value pairs.",
-							ElementType: types.StringType,
-							Computed:    true,
-						},
-						"type": schema.StringAttribute{
-							Description: "Type of Aembit integration (either `WizIntegrationApi` or `CrowdStrike`).",
-							Computed:    true,
-							Validators: []validator.String{
-								stringvalidator.OneOf([]string{"WizIntegrationApi", "CrowdStrike"}...),
-							},
-						},
-						"sync_frequency": schema.Int64Attribute{
-							Description: "Frequency to be used for synchronizing the integration.",
-							Computed:    true,
-						},
-						"endpoint": schema.StringAttribute{
-							Description: "Endpoint to be used for performing the integration.",
-							Computed:    true,
-						},
-						"oauth_client_credentials": schema.SingleNestedAttribute{
-							Description: "OAuth Client Credentials authentication information for the integration.",
-							Computed:    true,
-							Attributes: map[string]schema.Attribute{
-								"token_url": schema.StringAttribute{Required: true},
-								"client_id": schema.StringAttribute{Required: true},
-								"client_secret": schema.StringAttribute{
-									Computed:  true,
-									Sensitive: true,
-								},
-								"audience": schema.StringAttribute{Optional: true},
-							},
-						},
-					},
-				},
-			},
-		},
-	}
+// Schema defines the schema for the data source.
+func (d *integrationDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema, resp.Diagnostics = entitySchema(ctx, NewIntegrationsDataSource(), "integrations", "Looks up a single integration by id or name.", true)
 }
 
 // Read refreshes the Terraform state with the latest data.
-func (d *integrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state integrationsDataSourceModel
+func (d *integrationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	id, name, diags := getEntityLookup(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	integrations, err := d.client.GetIntegrations(nil)
+	integrations, err := cachedList(d.cache, "integration", d.client.GetIntegrations, func(e aembit.IntegrationDTO) aembit.EntityDTO { return e.EntityDTO })
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Aembit Integrations",
@@ -132,14 +72,20 @@ func (d *integrationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	// Map response body to model
-	for _, integration := range integrations {
-		integrationState := convertIntegrationDTOToModel(ctx, integration, integrationResourceModel{})
-		state.Integrations = append(state.Integrations, integrationState)
+	integration, err := findEntity(integrations, id, name, "integration", func(e aembit.IntegrationDTO) aembit.EntityDTO { return e.EntityDTO })
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Find Aembit Integration",
+			err.Error(),
+		)
+		return
 	}
 
+	// Map response body to model
+	state := convertIntegrationDTOToModel(ctx, integration, integrationResourceModel{})
+
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -28,4 +28,7 @@ type integrationOAuthClientCredentialsModel struct {
 // integrationDataSourceModel maps the datasource schema.
 type integrationsDataSourceModel struct {
 	Integrations []integrationResourceModel `tfsdk:"integrations"`
+	NameRegex    types.String               `tfsdk:"name_regex"`
+	Tags         types.Map                  `tfsdk:"tags"`
+	IsActive     types.Bool                 `tfsdk:"is_active"`
 }
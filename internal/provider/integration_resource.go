@@ -27,7 +27,9 @@ func NewIntegrationResource() resource.Resource {
 
 // integrationResource is the resource implementation.
 type integrationResource struct {
-	client *aembit.CloudClient
+	client *CloudClient
+	cache  *readCache
+	events *eventRecorder
 }
 
 // Metadata returns the resource type name.
@@ -41,18 +43,20 @@ func (r *integrationResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*aembit.CloudClient)
+	data, ok := req.ProviderData.(*aembitProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *aembit.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.cache = data.cache
+	r.events = data.events
 }
 
 // Schema defines the schema for the resource.
@@ -144,6 +148,8 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 	// Generate API request body from plan
 	var dto aembit.IntegrationDTO = convertIntegrationModelToDTO(ctx, plan, nil)
 
+	defer r.cache.invalidate("integration")
+
 	// Create new Integration
 	integration, err := r.client.CreateIntegration(dto, nil)
 	if err != nil {
@@ -163,6 +169,8 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.events.record(ctx, "integration", plan.ID.ValueString(), eventActionCreate)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -176,7 +184,7 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Get refreshed trust value from Aembit
-	integration, err := r.client.GetIntegration(state.ID.ValueString(), nil)
+	integration, err := cachedGet(r.cache, "integration", state.ID.ValueString(), r.client.GetIntegrations, r.client.GetIntegration, func(e aembit.IntegrationDTO) aembit.EntityDTO { return e.EntityDTO })
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Aembit Integration",
@@ -219,6 +227,8 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 	// Generate API request body from plan
 	var dto aembit.IntegrationDTO = convertIntegrationModelToDTO(ctx, plan, &externalID)
 
+	defer r.cache.invalidate("integration")
+
 	// Update Integration
 	integration, err := r.client.UpdateIntegration(dto, nil)
 	if err != nil {
@@ -238,6 +248,8 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.events.record(ctx, "integration", state.ID.ValueString(), eventActionUpdate)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -250,6 +262,8 @@ func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	defer r.cache.invalidate("integration")
+
 	// Check if Integration is Active - if it is, disable it first
 	if state.IsActive == types.BoolValue(true) {
 		_, err := r.client.DisableIntegration(state.ID.ValueString(), nil)
@@ -271,6 +285,8 @@ func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteReq
 		)
 		return
 	}
+
+	r.events.record(ctx, "integration", state.ID.ValueString(), eventActionDelete)
 }
 
 // Imports an existing resource by passing externalId.
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ validator.String = jwksValidator{}
+
+// jsonWebKeySet maps a JSON Web Key Set document, as served by the Kubernetes API server at /openid/v1/jwks.
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// jsonWebKey maps the public key parameters of RSA and EC JSON Web Keys.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// convertJWKSToPEM converts the signing keys of a JSON Web Key Set to PEM encoded public keys, as accepted by Aembit.
+func convertJWKSToPEM(jwks string) (string, error) {
+	var keySet jsonWebKeySet
+	if err := json.Unmarshal([]byte(jwks), &keySet); err != nil {
+		return "", fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	var publicKeys strings.Builder
+	for i, key := range keySet.Keys {
+		if len(key.Use) > 0 && key.Use != "sig" {
+			continue
+		}
+		publicKey, err := key.publicKey()
+		if err != nil {
+			return "", fmt.Errorf("key %d (%s): %w", i, key.Kid, err)
+		}
+		der, err := x509.MarshalPKIXPublicKey(publicKey)
+		if err != nil {
+			return "", fmt.Errorf("key %d (%s): %w", i, key.Kid, err)
+		}
+		_ = pem.Encode(&publicKeys, &pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	}
+	if publicKeys.Len() == 0 {
+		return "", fmt.Errorf("no signing keys found")
+	}
+	return publicKeys.String(), nil
+}
+
+// publicKey returns the RSA or EC public key of the JSON Web Key.
+func (k jsonWebKey) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil || len(n) == 0 {
+			return nil, fmt.Errorf("invalid RSA modulus")
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		curves := map[string]ecdh.Curve{"P-256": ecdh.P256(), "P-384": ecdh.P384(), "P-521": ecdh.P521()}
+		sizes := map[string]int{"P-256": 32, "P-384": 48, "P-521": 66}
+		curve, ok := curves[k.Crv]
+		if !ok {
+			return nil, fmt.Errorf("unsupported EC curve %q", k.Crv)
+		}
+		x, errX := base64.RawURLEncoding.DecodeString(k.X)
+		y, errY := base64.RawURLEncoding.DecodeString(k.Y)
+		size := sizes[k.Crv]
+		if errX != nil || errY != nil || len(x) > size || len(y) > size {
+			return nil, fmt.Errorf("invalid EC coordinates")
+		}
+		// Uncompressed point encoding: 0x04 followed by the padded X and Y coordinates.
+		point := make([]byte, 1+2*size)
+		point[0] = 4
+		copy(point[1+size-len(x):1+size], x)
+		copy(point[1+2*size-len(y):], y)
+		publicKey, err := curve.NewPublicKey(point)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EC public key: %w", err)
+		}
+		return publicKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// jwksValidator validates that a string is a JSON Web Key Set with at least one supported signing key.
+type jwksValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v jwksValidator) Description(_ context.Context) string {
+	return "value must be a JSON Web Key Set with RSA or EC signing keys"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v jwksValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v jwksValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := convertJWKSToPEM(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Web Key Set",
+			fmt.Sprintf("The value must be a JSON Web Key Set with RSA or EC signing keys: %s.", err.Error()),
+		)
+	}
+}
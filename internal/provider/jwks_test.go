@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConvertJWKSToPEM(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	encode := base64.RawURLEncoding.EncodeToString
+	jwks := fmt.Sprintf(`{"keys": [
+		{"use": "sig", "kty": "RSA", "kid": "rsa", "alg": "RS256", "n": %q, "e": %q},
+		{"use": "enc", "kty": "RSA", "kid": "encryption", "n": %q, "e": %q},
+		{"kty": "EC", "kid": "ec", "crv": "P-256", "x": %q, "y": %q}
+	]}`,
+		encode(rsaKey.N.Bytes()), encode(big.NewInt(int64(rsaKey.E)).Bytes()),
+		encode(rsaKey.N.Bytes()), encode(big.NewInt(int64(rsaKey.E)).Bytes()),
+		encode(ecKey.X.Bytes()), encode(ecKey.Y.Bytes()))
+
+	publicKeys, err := convertJWKSToPEM(jwks)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	blocks, err := decodePEMBlocks(publicKeys)
+	if err != nil || len(blocks) != 2 {
+		t.Fatalf("expected two signing keys, got %d: %v", len(blocks), err)
+	}
+	for i, expected := range []any{&rsaKey.PublicKey, &ecKey.PublicKey} {
+		der, _ := x509.MarshalPKIXPublicKey(expected)
+		if blocks[i].Type != "PUBLIC KEY" || string(blocks[i].Bytes) != string(der) {
+			t.Errorf("unexpected public key %d: %s", i, pem.EncodeToMemory(blocks[i]))
+		}
+	}
+
+	for _, invalid := range []string{
+		``,
+		`{"keys": []}`,
+		`{"keys": [{"kty": "oct", "k": "c2VjcmV0"}]}`,
+		`{"keys": [{"kty": "RSA", "n": "", "e": "AQAB"}]}`,
+		`{"keys": [{"kty": "EC", "crv": "P-256", "x": "AQ", "y": "AQ"}]}`,
+		`{"keys": [{"kty": "EC", "crv": "secp256k1", "x": "AQ", "y": "AQ"}]}`,
+	} {
+		if _, err := convertJWKSToPEM(invalid); err == nil {
+			t.Errorf("expected an error for %s", invalid)
+		}
+	}
+}
+
+func TestConvertKubernetesJwks(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	jwks := types.StringValue(fmt.Sprintf(`{"keys": [{"kty": "RSA", "n": %q, "e": "AQAB"}]}`, base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes())))
+	model := trustProviderResourceModel{KubernetesService: &trustProviderKubernetesModel{Jwks: jwks, PublicKey: newPEMNull()}}
+
+	dto, err := convertTrustProviderModelToDTO(context.Background(), model, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dto.PemType != "PublicKey" || len(dto.Certificate) == 0 {
+		t.Fatalf("expected the JWKS to be converted to a public key, got %s %s", dto.PemType, dto.Certificate)
+	}
+
+	converted := convertKubernetesDTOToModel(dto, model.KubernetesService)
+	if !converted.Jwks.Equal(jwks) || !converted.PublicKey.IsNull() || !converted.OIDCEndpoint.IsNull() {
+		t.Errorf("expected the JWKS to be kept from the state, got %v", converted)
+	}
+
+	imported := convertKubernetesDTOToModel(dto, nil)
+	if !imported.Jwks.IsNull() || imported.PublicKey.IsNull() {
+		t.Errorf("expected the public key without a JWKS in the state, got %v", imported)
+	}
+
+	dto.Certificate = ""
+	dto.OidcUrl = "https://oidc.example.com"
+	if endpoint := convertKubernetesDTOToModel(dto, nil); endpoint.OIDCEndpoint.ValueString() != dto.OidcUrl || !endpoint.PublicKey.IsNull() {
+		t.Errorf("expected the OIDC endpoint, got %v", endpoint)
+	}
+}
+
+func TestConvertKubernetesInvalidJwks(t *testing.T) {
+	model := trustProviderResourceModel{KubernetesService: &trustProviderKubernetesModel{Jwks: types.StringValue(`{"keys": []}`), PublicKey: newPEMNull()}}
+
+	if _, err := convertTrustProviderModelToDTO(context.Background(), model, nil); err == nil {
+		t.Error("expected an error for a JWKS without signing keys")
+	}
+}
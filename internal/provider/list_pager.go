@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+
+	"aembit.io/aembit"
+)
+
+// defaultPageSize is the number of entities requested per page when listing entities.
+const defaultPageSize = 100
+
+// CloudClient is the Aembit API client used by the provider and its subcommands. Its list methods request each list
+// a page at a time until every entity has been read, so that Tenants with more entities than a single list response
+// returns are read completely. All other methods are those of the aembit.io/aembit client.
+type CloudClient struct {
+	*aembit.CloudClient
+	pager *listPager
+}
+
+// listPager requests the pages of the Aembit API list endpoints.
+type listPager struct {
+	baseURL  string
+	token    string
+	pageSize int
+	client   *http.Client
+}
+
+// newCloudClient creates the Aembit API client for the Tenant on the stack.
+func newCloudClient(tenant, stackDomain, token, version string, pageSize int) (*CloudClient, error) {
+	client, err := aembit.NewClient(aembit.URLBuilder{}, &token, version)
+	if err != nil {
+		return nil, err
+	}
+	client.Tenant = tenant
+	client.StackDomain = stackDomain
+
+	return &CloudClient{
+		CloudClient: client,
+		pager: &listPager{
+			baseURL:  fmt.Sprintf("https://%s.api.%s/api/v1", tenant, stackDomain),
+			token:    token,
+			pageSize: pageSize,
+			client:   &http.Client{},
+		},
+	}, nil
+}
+
+// getPageSize returns the page size set with the AEMBIT_PAGE_SIZE environment variable, or the defaultPageSize.
+func getPageSize() (int, error) {
+	value := os.Getenv("AEMBIT_PAGE_SIZE")
+	if len(value) == 0 {
+		return defaultPageSize, nil
+	}
+
+	pageSize, err := strconv.Atoi(value)
+	if err != nil || pageSize < 1 {
+		return 0, fmt.Errorf("AEMBIT_PAGE_SIZE must be a positive whole number, got: %s", value)
+	}
+	return pageSize, nil
+}
+
+// GetServerWorkloads lists every Server Workload of the Tenant.
+func (c *CloudClient) GetServerWorkloads(h http.Header) ([]aembit.ServerWorkloadExternalDTO, error) {
+	return listAll(c.pager, "server-workloads", "serverWorkloads", h, func(e aembit.ServerWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO })
+}
+
+// GetClientWorkloads lists every Client Workload of the Tenant.
+func (c *CloudClient) GetClientWorkloads(h http.Header) ([]aembit.ClientWorkloadExternalDTO, error) {
+	return listAll(c.pager, "client-workloads", "clientWorkloads", h, func(e aembit.ClientWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO })
+}
+
+// GetTrustProviders lists every Trust Provider of the Tenant.
+func (c *CloudClient) GetTrustProviders(h http.Header) ([]aembit.TrustProviderDTO, error) {
+	return listAll(c.pager, "trust-providers", "trustProviders", h, func(e aembit.TrustProviderDTO) aembit.EntityDTO { return e.EntityDTO })
+}
+
+// GetCredentialProviders lists every Credential Provider of the Tenant.
+func (c *CloudClient) GetCredentialProviders(h http.Header) ([]aembit.CredentialProviderDTO, error) {
+	return listAll(c.pager, "credential-providers", "credentialProviders", h, func(e aembit.CredentialProviderDTO) aembit.EntityDTO { return e.EntityDTO })
+}
+
+// GetIntegrations lists every Integration of the Tenant.
+func (c *CloudClient) GetIntegrations(h http.Header) ([]aembit.IntegrationDTO, error) {
+	return listAll(c.pager, "integrations", "integrations", h, func(e aembit.IntegrationDTO) aembit.EntityDTO { return e.EntityDTO })
+}
+
+// GetAccessConditions lists every Access Condition of the Tenant.
+func (c *CloudClient) GetAccessConditions(h http.Header) ([]aembit.AccessConditionDTO, error) {
+	return listAll(c.pager, "access-conditions", "accessConditions", h, func(e aembit.AccessConditionDTO) aembit.EntityDTO { return e.EntityDTO })
+}
+
+// GetAgentControllers lists every Agent Controller of the Tenant.
+func (c *CloudClient) GetAgentControllers(h http.Header) ([]aembit.AgentControllerDTO, error) {
+	return listAll(c.pager, "agent-controllers", "agentControllers", h, func(e aembit.AgentControllerDTO) aembit.EntityDTO { return e.EntityDTO })
+}
+
+// GetAccessPolicies lists every Access Policy of the Tenant.
+func (c *CloudClient) GetAccessPolicies(h http.Header) ([]aembit.PolicyExternalDTO, error) {
+	return listAll(c.pager, "access-policies", "accessPolicies", h, func(e aembit.PolicyExternalDTO) aembit.EntityDTO { return e.EntityDTO })
+}
+
+// GetRoles lists every Role of the Tenant. The aembit.io/aembit client has no Role DTO, so only the common entity
+// fields of each Role are returned.
+func (c *CloudClient) GetRoles(h http.Header) ([]aembit.EntityDTO, error) {
+	return listAll(c.pager, "roles", "roles", h, func(e aembit.EntityDTO) aembit.EntityDTO { return e })
+}
+
+// listAll requests the pages of the list endpoint until every entity has been read, in API order. Listing stops at a
+// short page, or once the recordsTotal of the list has been read. An endpoint which ignores the paging parameters
+// returns the same entities for every page, so listing also stops at a page without new entities; the list then
+// fails if it is still short of the recordsTotal, rather than returning incomplete results.
+func listAll[T any](p *listPager, path, field string, h http.Header, entity func(T) aembit.EntityDTO) ([]T, error) {
+	var items []T
+	seen := make(map[string]bool)
+	for page := 1; ; page++ {
+		pageItems, total, err := getListPage[T](p, path, field, page, h)
+		if err != nil {
+			return nil, err
+		}
+
+		// Entities created while listing shift the later pages, so skip entities already read from a previous page
+		added := 0
+		for _, item := range pageItems {
+			id := entity(item).ExternalID
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			items = append(items, item)
+			added++
+		}
+
+		if total > 0 && len(items) >= total {
+			return items, nil
+		}
+		if added == 0 && len(pageItems) > 0 {
+			if total > 0 {
+				return nil, fmt.Errorf("listing %s returned %d of %d entities, page %d repeats the previous pages", path, len(items), total, page)
+			}
+			return items, nil
+		}
+		if len(pageItems) != p.pageSize {
+			return items, nil
+		}
+	}
+}
+
+// getListPage requests a single page of the list endpoint, and returns its entities and the recordsTotal of the list.
+// The recordsTotal is 0 if the response does not report it.
+func getListPage[T any](p *listPager, path, field string, page int, h http.Header) ([]T, int, error) {
+	url := fmt.Sprintf("%s/%s?page=%d&per-page=%d", p.baseURL, path, page, p.pageSize)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	for key, values := range h {
+		req.Header[key] = values
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+p.token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, 0, fmt.Errorf("failed to list page %d of %s: %s: %s", page, path, resp.Status, body)
+	}
+
+	var response map[string]json.RawMessage
+	if err = json.Unmarshal(body, &response); err != nil {
+		return nil, 0, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	rawItems, ok := response[field]
+	if !ok {
+		return nil, 0, fmt.Errorf("the %s list response has no %s field", path, field)
+	}
+
+	var items []T
+	if err = json.Unmarshal(rawItems, &items); err != nil {
+		return nil, 0, fmt.Errorf("failed to unmarshal %s: %w", field, err)
+	}
+	var total int
+	if rawTotal, ok := response["recordsTotal"]; ok {
+		if err = json.Unmarshal(rawTotal, &total); err != nil {
+			return nil, 0, fmt.Errorf("failed to unmarshal recordsTotal: %w", err)
+		}
+	}
+	return items, total, nil
+}
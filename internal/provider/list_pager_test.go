@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"aembit.io/aembit"
+)
+
+// newTestCloudClient returns a client of the test server, which lists total Server Workloads a page at a time and
+// records the requested pages. The server returns every Server Workload for each page if ignorePaging is set.
+func newTestCloudClient(t *testing.T, total, pageSize int, ignorePaging bool, pages *[]string) *CloudClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/server-workloads" || r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		*pages = append(*pages, r.URL.RawQuery)
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per-page"))
+		if ignorePaging {
+			page, perPage = 1, total
+		}
+		items := []aembit.ServerWorkloadExternalDTO{}
+		for i := (page - 1) * perPage; i < page*perPage && i < total; i++ {
+			items = append(items, aembit.ServerWorkloadExternalDTO{EntityDTO: aembit.EntityDTO{ExternalID: strconv.Itoa(i), Name: fmt.Sprintf("workload %d", i)}})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"serverWorkloads": items, "recordsTotal": total})
+	}))
+	t.Cleanup(server.Close)
+
+	return &CloudClient{pager: &listPager{baseURL: server.URL, token: "token", pageSize: pageSize, client: server.Client()}}
+}
+
+func TestCloudClientListPaging(t *testing.T) {
+	cases := map[string]struct {
+		total        int
+		ignorePaging bool
+		pages        []string
+	}{
+		"short last page": {5, false, []string{"page=1&per-page=2", "page=2&per-page=2", "page=3&per-page=2"}},
+		"full last page":  {4, false, []string{"page=1&per-page=2", "page=2&per-page=2"}},
+		"empty":           {0, false, []string{"page=1&per-page=2"}},
+		"ignored paging":  {5, true, []string{"page=1&per-page=2"}},
+	}
+	for name, c := range cases {
+		var pages []string
+		items, err := newTestCloudClient(t, c.total, 2, c.ignorePaging, &pages).GetServerWorkloads(nil)
+		if err != nil || len(items) != c.total {
+			t.Errorf("%s: GetServerWorkloads returned %d entities (%v), want %d", name, len(items), err, c.total)
+		}
+		for i, item := range items {
+			if item.ExternalID != strconv.Itoa(i) {
+				t.Errorf("%s: entity %d is %s, want the API order", name, i, item.ExternalID)
+			}
+		}
+		if strings.Join(pages, " ") != strings.Join(c.pages, " ") {
+			t.Errorf("%s: requested pages %v, want %v", name, pages, c.pages)
+		}
+	}
+}
+
+func TestCloudClientListErrors(t *testing.T) {
+	cases := map[string]struct {
+		status   int
+		body     string
+		contains string
+	}{
+		"error status":  {http.StatusForbidden, `{"message":"forbidden"}`, "403 Forbidden"},
+		"missing field": {http.StatusOK, `{"items":[]}`, "no serverWorkloads field"},
+		"truncated":     {http.StatusOK, `{"recordsTotal":3,"serverWorkloads":[{"externalId":"1"},{"externalId":"2"}]}`, "returned 2 of 3 entities"},
+	}
+	for name, c := range cases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(c.status)
+			_, _ = w.Write([]byte(c.body))
+		}))
+		client := &CloudClient{pager: &listPager{baseURL: server.URL, token: "token", pageSize: 2, client: server.Client()}}
+		_, err := client.GetServerWorkloads(nil)
+		if err == nil || !strings.Contains(err.Error(), c.contains) {
+			t.Errorf("%s: GetServerWorkloads error = %v, want it to contain %q", name, err, c.contains)
+		}
+		server.Close()
+	}
+}
+
+func TestGetPageSize(t *testing.T) {
+	t.Setenv("AEMBIT_PAGE_SIZE", "")
+	if pageSize, err := getPageSize(); err != nil || pageSize != defaultPageSize {
+		t.Errorf("getPageSize = %d, %v, want the default page size", pageSize, err)
+	}
+
+	t.Setenv("AEMBIT_PAGE_SIZE", "25")
+	if pageSize, err := getPageSize(); err != nil || pageSize != 25 {
+		t.Errorf("getPageSize = %d, %v, want 25", pageSize, err)
+	}
+
+	for _, value := range []string{"0", "-1", "all"} {
+		t.Setenv("AEMBIT_PAGE_SIZE", value)
+		if _, err := getPageSize(); err == nil {
+			t.Errorf("expected an error for the page size %q", value)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &parseClientIDFunction{}
+
+// NewParseClientIDFunction is a helper function to simplify the provider implementation.
+func NewParseClientIDFunction() function.Function {
+	return &parseClientIDFunction{}
+}
+
+// parseClientIDFunction is the function implementation.
+type parseClientIDFunction struct{}
+
+// parseClientIDFunctionResult maps the function result object.
+type parseClientIDFunctionResult struct {
+	Tenant       types.String `tfsdk:"tenant"`
+	IdentityType types.String `tfsdk:"identity_type"`
+	ID           types.String `tfsdk:"id"`
+}
+
+// Metadata returns the function name.
+func (f *parseClientIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_client_id"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *parseClientIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse an Aembit Client ID",
+		Description: "Parses an Aembit Trust Provider Client ID (e.g. `aembit:useast2:tenant:identity:github_idtoken:<id>`) into its tenant, identity type and identifier.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "client_id",
+				Description: "Aembit Trust Provider Client ID.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"tenant":        types.StringType,
+				"identity_type": types.StringType,
+				"id":            types.StringType,
+			},
+		},
+	}
+}
+
+// Run parses the Client ID.
+func (f *parseClientIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var clientID string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &clientID))
+	if resp.Error != nil {
+		return
+	}
+
+	clientIDSplit := strings.Split(clientID, ":")
+	if len(clientIDSplit) != 6 || clientIDSplit[0] != "aembit" {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid Aembit Client ID, expected the format aembit:<stack>:<tenant>:identity:<identity_type>:<id>")
+		return
+	}
+
+	result := parseClientIDFunctionResult{
+		Tenant:       types.StringValue(getAembitTenantId(clientID)),
+		IdentityType: types.StringValue(getAembitIdentityType(clientID)),
+		ID:           types.StringValue(getAembitClientIdentifier(clientID)),
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, &result))
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseClientIDFunction(t *testing.T) {
+	ctx := context.Background()
+	attributeTypes := map[string]attr.Type{
+		"tenant":        types.StringType,
+		"identity_type": types.StringType,
+		"id":            types.StringType,
+	}
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("aembit:useast2:a12bc3:identity:github_idtoken:0bc4dbcd-e9c8-445b-ac90-28f47b8649cc")}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(attributeTypes))}
+	NewParseClientIDFunction().Run(ctx, req, &resp)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %v", resp.Error)
+	}
+
+	expected := types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+		"tenant":        types.StringValue("a12bc3"),
+		"identity_type": types.StringValue("github_idtoken"),
+		"id":            types.StringValue("0bc4dbcd-e9c8-445b-ac90-28f47b8649cc"),
+	})
+	if !resp.Result.Value().Equal(expected) {
+		t.Errorf("unexpected result %v", resp.Result.Value())
+	}
+
+	for _, clientID := range []string{"", "aembit:useast2:a12bc3", "other:useast2:a12bc3:identity:github_idtoken:id"} {
+		req.Arguments = function.NewArgumentsData([]attr.Value{types.StringValue(clientID)})
+		resp = function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(attributeTypes))}
+		NewParseClientIDFunction().Run(ctx, req, &resp)
+		if resp.Error == nil {
+			t.Errorf("expected an error for client id %q", clientID)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = pemType{}
+	_ basetypes.StringValuableWithSemanticEquals = pemValue{}
+)
+
+// pemType is a string type for PEM encoded keys and certificates. Values which encode the same PEM blocks are
+// semantically equal, so that differences in whitespace, line endings or line wrapping do not cause a diff.
+type pemType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t pemType) String() string {
+	return "pemType"
+}
+
+// Equal returns true if the given type is equivalent.
+func (t pemType) Equal(o attr.Type) bool {
+	other, ok := o.(pemType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueType returns the Value type.
+func (t pemType) ValueType(_ context.Context) attr.Value {
+	return pemValue{}
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t pemType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return pemValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t pemType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return pemValue{StringValue: stringValue}, nil
+}
+
+// pemValue is the value of a pemType attribute.
+type pemValue struct {
+	basetypes.StringValue
+}
+
+// newPEMNull creates a pemValue with a null value.
+func newPEMNull() pemValue {
+	return pemValue{StringValue: basetypes.NewStringNull()}
+}
+
+// newPEMValue creates a pemValue with a known value.
+func newPEMValue(value string) pemValue {
+	return pemValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+// Type returns the pemType.
+func (v pemValue) Type(_ context.Context) attr.Type {
+	return pemType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v pemValue) Equal(o attr.Value) bool {
+	other, ok := o.(pemValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both values encode the same PEM blocks.
+func (v pemValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(pemValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return pemBlocksEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+// pemBlocksEqual returns true if both values decode to the same PEM blocks.
+func pemBlocksEqual(value, other string) bool {
+	blocks, err := decodePEMBlocks(value)
+	if err != nil {
+		return false
+	}
+	otherBlocks, err := decodePEMBlocks(other)
+	if err != nil || len(blocks) != len(otherBlocks) {
+		return false
+	}
+	for i := range blocks {
+		if blocks[i].Type != otherBlocks[i].Type || !bytes.Equal(blocks[i].Bytes, otherBlocks[i].Bytes) {
+			return false
+		}
+	}
+	return true
+}
+
+// decodePEMBlocks decodes all PEM blocks of the value, which must not contain anything else.
+func decodePEMBlocks(value string) ([]*pem.Block, error) {
+	value = strings.TrimSpace(value)
+	if !strings.HasPrefix(value, "-----BEGIN ") {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	var blocks []*pem.Block
+	rest := []byte(value)
+	for {
+		block, next := pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		blocks = append(blocks, block)
+		rest = next
+	}
+	if len(blocks) == 0 {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+	if len(strings.TrimSpace(string(rest))) > 0 {
+		return nil, fmt.Errorf("unexpected content after the last PEM block")
+	}
+	return blocks, nil
+}
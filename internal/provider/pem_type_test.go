@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPEMValueSemanticEquals(t *testing.T) {
+	publicKey := "-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A\nMIIBCgKCAQEAs2Kd\n-----END PUBLIC KEY-----\n"
+
+	cases := map[string]struct {
+		value   string
+		isEqual bool
+	}{
+		"identical":            {publicKey, true},
+		"crlf line endings":    {strings.ReplaceAll(publicKey, "\n", "\r\n"), true},
+		"no trailing newline":  {strings.TrimSuffix(publicKey, "\n"), true},
+		"surrounding space":    {"\n  " + publicKey + "\n\n", true},
+		"rewrapped":            {"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAs2Kd\n-----END PUBLIC KEY-----\n", true},
+		"different key":        {"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A\n-----END PUBLIC KEY-----\n", false},
+		"different block type": {strings.ReplaceAll(publicKey, "PUBLIC KEY", "CERTIFICATE"), false},
+		"additional block":     {publicKey + publicKey, false},
+		"not pem":              {"MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAs2Kd", false},
+	}
+	for name, c := range cases {
+		isEqual, diags := newPEMValue(publicKey).StringSemanticEquals(context.Background(), newPEMValue(c.value))
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected error: %v", name, diags)
+		}
+		if isEqual != c.isEqual {
+			t.Errorf("%s: expected semantic equality %t, got %t", name, c.isEqual, isEqual)
+		}
+	}
+}
+
+func TestPEMTypeValueFromTerraform(t *testing.T) {
+	value, err := pemType{}.ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.String, "key"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !value.Equal(newPEMValue("key")) {
+		t.Errorf("unexpected value %v", value)
+	}
+}
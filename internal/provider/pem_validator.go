@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ validator.String = pemValidator{}
+	_ validator.String = pemCertificateValidator{}
+	_ validator.String = pemCertificateRequestValidator{}
+)
+
+// pemValidator validates that a string is made of PEM blocks, e.g. a PEM encoded public key.
+type pemValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v pemValidator) Description(_ context.Context) string {
+	return "value must be PEM encoded"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v pemValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v pemValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := decodePEMBlocks(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid PEM",
+			fmt.Sprintf("The value must be PEM encoded: %s.", err.Error()),
+		)
+	}
+}
+
+// pemCertificateValidator validates that a string holds a PEM encoded X.509 certificate.
+type pemCertificateValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v pemCertificateValidator) Description(_ context.Context) string {
+	return "value must be a PEM encoded X.509 certificate"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v pemCertificateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v pemCertificateValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := parsePEMCertificate(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid PEM Certificate",
+			fmt.Sprintf("The value must be a PEM encoded X.509 certificate: %s.", err.Error()),
+		)
+	}
+}
+
+// pemCertificateRequestValidator validates that a string holds a PEM encoded PKCS#10 certificate signing request.
+type pemCertificateRequestValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v pemCertificateRequestValidator) Description(_ context.Context) string {
+	return "value must be a PEM encoded certificate signing request"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v pemCertificateRequestValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v pemCertificateRequestValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := parsePEMCertificateRequest(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Certificate Signing Request",
+			fmt.Sprintf("The value must be a PEM encoded CERTIFICATE REQUEST: %s.", err.Error()),
+		)
+	}
+}
+
+// parsePEMCertificate verifies that the value holds a single PEM encoded X.509 certificate.
+func parsePEMCertificate(value string) error {
+	blocks, err := decodePEMBlocks(value)
+	if err != nil {
+		return err
+	}
+	if len(blocks) > 1 {
+		return fmt.Errorf("more than one PEM block found")
+	}
+	if blocks[0].Type != "CERTIFICATE" {
+		return fmt.Errorf("unexpected PEM block type %s", blocks[0].Type)
+	}
+	_, err = x509.ParseCertificate(blocks[0].Bytes)
+	return err
+}
+
+// parsePEMCertificateRequest verifies that the value holds a single PEM encoded certificate signing request, signed
+// by the key it requests a certificate for.
+func parsePEMCertificateRequest(value string) error {
+	blocks, err := decodePEMBlocks(value)
+	if err != nil {
+		return err
+	}
+	if len(blocks) > 1 {
+		return fmt.Errorf("more than one PEM block found")
+	}
+	if blocks[0].Type != "CERTIFICATE REQUEST" {
+		return fmt.Errorf("unexpected PEM block type %s", blocks[0].Type)
+	}
+	csr, err := x509.ParseCertificateRequest(blocks[0].Bytes)
+	if err != nil {
+		return err
+	}
+	return csr.CheckSignature()
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"encoding/pem"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPEMCertificateValidator(t *testing.T) {
+	certificate := testAWSCertificate(t)
+
+	cases := map[string]struct {
+		value   types.String
+		isValid bool
+	}{
+		"certificate": {types.StringValue(certificate), true},
+		"null":        {types.StringNull(), true},
+		"unknown":     {types.StringUnknown(), true},
+		"empty":       {types.StringValue(""), false},
+		"not pem":     {types.StringValue("certificate"), false},
+		"public key":  {types.StringValue("-----BEGIN PUBLIC KEY-----\nAAAA\n-----END PUBLIC KEY-----\n"), false},
+		"truncated":   {types.StringValue("-----BEGIN CERTIFICATE-----\nMIIDIjCCAougAwIBAgIJAKnL4UEDMN\n-----END CERTIFICATE-----\n"), false},
+		"two blocks":  {types.StringValue(certificate + certificate), false},
+	}
+	for name, c := range cases {
+		req := validator.StringRequest{Path: path.Root("certificate"), ConfigValue: c.value}
+		var resp validator.StringResponse
+		pemCertificateValidator{}.ValidateString(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() == c.isValid {
+			t.Errorf("%s: expected valid %t, got %v", name, c.isValid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestPEMValidator(t *testing.T) {
+	cases := map[string]struct {
+		value   types.String
+		isValid bool
+	}{
+		"null":          {types.StringNull(), true},
+		"pem":           {types.StringValue("-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A\n-----END PUBLIC KEY-----\n"), true},
+		"empty":         {types.StringValue(""), false},
+		"leading text":  {types.StringValue("key\n-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A\n-----END PUBLIC KEY-----\n"), false},
+		"trailing text": {types.StringValue("-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A\n-----END PUBLIC KEY-----\nkey"), false},
+		"missing end":   {types.StringValue("-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A\n"), false},
+	}
+	for name, c := range cases {
+		req := validator.StringRequest{Path: path.Root("public_key"), ConfigValue: c.value}
+		var resp validator.StringResponse
+		pemValidator{}.ValidateString(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() == c.isValid {
+			t.Errorf("%s: expected valid %t, got %v", name, c.isValid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestPEMCertificateRequestValidator(t *testing.T) {
+	_, csrPEM, err := generateCertificateSigningRequest("workload", "db.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	block, _ := pem.Decode([]byte(csrPEM))
+	block.Bytes[len(block.Bytes)-1] ^= 0xff
+	badSignature := string(pem.EncodeToMemory(block))
+
+	cases := map[string]struct {
+		value   types.String
+		isValid bool
+	}{
+		"csr":           {types.StringValue(csrPEM), true},
+		"null":          {types.StringNull(), true},
+		"unknown":       {types.StringUnknown(), true},
+		"empty":         {types.StringValue(""), false},
+		"not pem":       {types.StringValue("csr"), false},
+		"public key":    {types.StringValue("-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A\n-----END PUBLIC KEY-----\n"), false},
+		"bad signature": {types.StringValue(badSignature), false},
+		"two blocks":    {types.StringValue(csrPEM + csrPEM), false},
+	}
+	for name, c := range cases {
+		req := validator.StringRequest{Path: path.Root("certificate_signing_request"), ConfigValue: c.value}
+		var resp validator.StringResponse
+		pemCertificateRequestValidator{}.ValidateString(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() == c.isValid {
+			t.Errorf("%s: expected valid %t, got %v", name, c.isValid, resp.Diagnostics)
+		}
+	}
+}
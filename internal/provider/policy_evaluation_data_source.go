@@ -0,0 +1,406 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"aembit.io/aembit"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &policyEvaluationDataSource{}
+	_ datasource.DataSourceWithConfigure = &policyEvaluationDataSource{}
+)
+
+// NewPolicyEvaluationDataSource is a helper function to simplify the provider implementation.
+func NewPolicyEvaluationDataSource() datasource.DataSource {
+	return &policyEvaluationDataSource{}
+}
+
+// policyEvaluationDataSource is the data source implementation.
+type policyEvaluationDataSource struct {
+	client *CloudClient
+	cache  *readCache
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *policyEvaluationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*aembitProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+	d.cache = data.cache
+}
+
+// Metadata returns the data source type name.
+func (d *policyEvaluationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_evaluation"
+}
+
+// Schema defines the schema for the data source.
+func (d *policyEvaluationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Evaluates whether the Access Policies of the Tenant would grant a Client Workload access to a Server Workload, " +
+			"given the declared identities of both Workloads, Trust Provider claims and passed Access Conditions.",
+		Attributes: map[string]schema.Attribute{
+			"client_workload_id": schema.StringAttribute{
+				Description: "Unique identifier of the Client Workload to evaluate.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("client_workload_id"), path.MatchRoot("client_identities")),
+				},
+			},
+			"client_identities": schema.ListNestedAttribute{
+				Description: "Identities of the Client Workload to evaluate, with the `type` and `value` of the `identities` of an `aembit_client_workload`. " +
+					"A Client Workload matches if each of its identities is declared.",
+				Optional: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Client identity type, e.g. `k8sNamespace`.",
+							Required:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "Client identity value.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"server_workload_id": schema.StringAttribute{
+				Description: "Unique identifier of the Server Workload to evaluate.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("server_workload_id"), path.MatchRoot("target_host")),
+				},
+			},
+			"target_host": schema.StringAttribute{
+				Description: "Hostname of the Server Workload to evaluate.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("target_port")),
+				},
+			},
+			"target_port": schema.Int64Attribute{
+				Description: "Port of the Server Workload to evaluate.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+					int64validator.AlsoRequires(path.MatchRoot("target_host")),
+				},
+			},
+			"transport_protocol": schema.StringAttribute{
+				Description: "Transport Protocol of the Server Workload to evaluate. Defaults to `TCP`.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"TCP", "UDP"}...),
+					stringvalidator.ConflictsWith(path.MatchRoot("server_workload_id")),
+				},
+			},
+			"trust_provider_claims": schema.MapAttribute{
+				Description: "Attested claims of the Client Workload, mapped from the Trust Provider match rule attribute, e.g. `TerraformWorkspaceId`, to its value. " +
+					"A Trust Provider is satisfied if each of its match rule attributes is declared with one of the matching values.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"passed_access_conditions": schema.SetAttribute{
+				Description: "Unique identifiers of the Access Conditions the Client Workload passes.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"allowed": schema.BoolAttribute{
+				Description: "True if an Access Policy grants the Client Workload access to the Server Workload.",
+				Computed:    true,
+			},
+			"policy_id": schema.StringAttribute{
+				Description: "Unique identifier of the Access Policy which grants access or, if access is not granted, of the first Access Policy " +
+					"between the Workloads.",
+				Computed: true,
+			},
+			"matched_client_workload_id": schema.StringAttribute{
+				Description: "Unique identifier of the Client Workload of the evaluated Access Policy.",
+				Computed:    true,
+			},
+			"matched_server_workload_id": schema.StringAttribute{
+				Description: "Unique identifier of the Server Workload of the evaluated Access Policy.",
+				Computed:    true,
+			},
+			"reason": schema.StringAttribute{
+				Description: "Reason the Access Policy grants access, or why no Access Policy does.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read evaluates the Access Policies of the Tenant.
+func (d *policyEvaluationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state policyEvaluationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.TargetHost.IsNull() {
+		state.TransportProtocol = types.StringNull()
+	} else if state.TransportProtocol.IsNull() || state.TransportProtocol.IsUnknown() {
+		state.TransportProtocol = types.StringValue("TCP")
+	}
+
+	request := policyEvaluationRequest{
+		clientWorkloadID:  state.ClientWorkloadID.ValueString(),
+		serverWorkloadID:  state.ServerWorkloadID.ValueString(),
+		targetHost:        state.TargetHost.ValueString(),
+		targetPort:        int(state.TargetPort.ValueInt64()),
+		transportProtocol: state.TransportProtocol.ValueString(),
+	}
+	for _, identity := range state.ClientIdentities {
+		request.clientIdentities = append(request.clientIdentities, aembit.ClientWorkloadIdentityDTO{
+			Type:  identity.Type.ValueString(),
+			Value: identity.Value.ValueString(),
+		})
+	}
+	if !state.TrustProviderClaims.IsNull() {
+		resp.Diagnostics.Append(state.TrustProviderClaims.ElementsAs(ctx, &request.claims, false)...)
+	}
+	if !state.PassedAccessConditions.IsNull() {
+		resp.Diagnostics.Append(state.PassedAccessConditions.ElementsAs(ctx, &request.passedAccessConditions, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var entities policyEvaluationEntities
+	var err error
+	entities.clientWorkloads, err = cachedList(d.cache, "client_workload", d.client.GetClientWorkloads, func(e aembit.ClientWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO })
+	if err == nil {
+		entities.serverWorkloads, err = cachedList(d.cache, "server_workload", d.client.GetServerWorkloads, func(e aembit.ServerWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO })
+	}
+	if err == nil {
+		entities.trustProviders, err = cachedList(d.cache, "trust_provider", d.client.GetTrustProviders, func(e aembit.TrustProviderDTO) aembit.EntityDTO { return e.EntityDTO })
+	}
+	if err == nil {
+		entities.accessPolicies, err = cachedList(d.cache, "access_policy", d.client.GetAccessPolicies, func(e aembit.PolicyExternalDTO) aembit.EntityDTO { return e.EntityDTO })
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Evaluate Aembit Access Policies",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map the evaluation to model
+	result := evaluatePolicy(request, entities)
+	state.Allowed = types.BoolValue(result.allowed)
+	state.PolicyID = stringOrNull(result.policyID)
+	state.MatchedClientWorkload = stringOrNull(result.clientWorkloadID)
+	state.MatchedServerWorkload = stringOrNull(result.serverWorkloadID)
+	state.Reason = types.StringValue(result.reason)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// policyEvaluationRequest declares the Client Workload and Server Workload to evaluate the Access Policies for.
+type policyEvaluationRequest struct {
+	clientWorkloadID       string
+	clientIdentities       []aembit.ClientWorkloadIdentityDTO
+	serverWorkloadID       string
+	targetHost             string
+	targetPort             int
+	transportProtocol      string
+	claims                 map[string]string
+	passedAccessConditions []string
+}
+
+// policyEvaluationEntities are the entities of the Tenant the Access Policies are evaluated with.
+type policyEvaluationEntities struct {
+	clientWorkloads []aembit.ClientWorkloadExternalDTO
+	serverWorkloads []aembit.ServerWorkloadExternalDTO
+	trustProviders  []aembit.TrustProviderDTO
+	accessPolicies  []aembit.PolicyExternalDTO
+}
+
+// policyEvaluationResult is the outcome of evaluating the Access Policies.
+type policyEvaluationResult struct {
+	allowed          bool
+	policyID         string
+	clientWorkloadID string
+	serverWorkloadID string
+	reason           string
+}
+
+// evaluatePolicy evaluates the Access Policies between the declared Client Workload and Server Workload. Access is
+// granted by the first Access Policy which, along with its Workloads, is active, has a Trust Provider satisfied by the
+// declared claims (unless it has no active Trust Providers), and whose active Access Conditions were all passed. If no
+// Access Policy grants access, the result describes the first Access Policy between the Workloads.
+func evaluatePolicy(request policyEvaluationRequest, entities policyEvaluationEntities) policyEvaluationResult {
+	clientWorkloads := make(map[string]aembit.ClientWorkloadExternalDTO)
+	for _, workload := range entities.clientWorkloads {
+		if matchesClientWorkload(request, workload) {
+			clientWorkloads[workload.ExternalID] = workload
+		}
+	}
+	if len(clientWorkloads) == 0 {
+		return policyEvaluationResult{reason: "No Client Workload matches the declared client identities."}
+	}
+
+	serverWorkloads := make(map[string]aembit.ServerWorkloadExternalDTO)
+	for _, workload := range entities.serverWorkloads {
+		if matchesServerWorkload(request, workload) {
+			serverWorkloads[workload.ExternalID] = workload
+		}
+	}
+	if len(serverWorkloads) == 0 {
+		return policyEvaluationResult{reason: "No Server Workload matches the declared target."}
+	}
+
+	var denied *policyEvaluationResult
+	for _, policy := range entities.accessPolicies {
+		clientWorkload, clientOk := clientWorkloads[policy.ClientWorkload.ExternalID]
+		serverWorkload, serverOk := serverWorkloads[policy.ServerWorkload.ExternalID]
+		if !clientOk || !serverOk {
+			continue
+		}
+
+		result := policyEvaluationResult{
+			policyID:         policy.ExternalID,
+			clientWorkloadID: clientWorkload.ExternalID,
+			serverWorkloadID: serverWorkload.ExternalID,
+		}
+		result.reason = getPolicyDenialReason(request, entities.trustProviders, policy, clientWorkload, serverWorkload)
+		if len(result.reason) == 0 {
+			result.allowed = true
+			result.reason = fmt.Sprintf("Access Policy %s grants access.", policy.ExternalID)
+			return result
+		}
+		if denied == nil {
+			denied = &result
+		}
+	}
+	if denied != nil {
+		return *denied
+	}
+	return policyEvaluationResult{reason: "No Access Policy grants a matching Client Workload access to a matching Server Workload."}
+}
+
+// getPolicyDenialReason returns why the Access Policy does not grant access, or an empty string if it does.
+func getPolicyDenialReason(request policyEvaluationRequest, trustProviders []aembit.TrustProviderDTO, policy aembit.PolicyExternalDTO, clientWorkload aembit.ClientWorkloadExternalDTO, serverWorkload aembit.ServerWorkloadExternalDTO) string {
+	switch {
+	case !policy.IsActive:
+		return fmt.Sprintf("Access Policy %s is not active.", policy.ExternalID)
+	case !clientWorkload.IsActive:
+		return fmt.Sprintf("Client Workload %s of Access Policy %s is not active.", clientWorkload.ExternalID, policy.ExternalID)
+	case !serverWorkload.IsActive:
+		return fmt.Sprintf("Server Workload %s of Access Policy %s is not active.", serverWorkload.ExternalID, policy.ExternalID)
+	}
+
+	var activeTrustProviders []string
+	satisfied := false
+	for _, ref := range policy.TrustProviders {
+		for _, trustProvider := range trustProviders {
+			if trustProvider.ExternalID == ref.ExternalID && trustProvider.IsActive {
+				activeTrustProviders = append(activeTrustProviders, trustProvider.ExternalID)
+				satisfied = satisfied || matchesTrustProvider(request.claims, trustProvider)
+			}
+		}
+	}
+	if len(activeTrustProviders) > 0 && !satisfied {
+		return fmt.Sprintf("The declared claims satisfy none of the active Trust Providers %s of Access Policy %s.", strings.Join(activeTrustProviders, ", "), policy.ExternalID)
+	}
+
+	for _, accessCondition := range policy.AccessConditions {
+		if accessCondition.IsActive && !slices.Contains(request.passedAccessConditions, accessCondition.ExternalID) {
+			return fmt.Sprintf("Access Condition %s of Access Policy %s is not declared as passed.", accessCondition.ExternalID, policy.ExternalID)
+		}
+	}
+	return ""
+}
+
+// matchesClientWorkload returns true if the Client Workload is the declared one, or each of its identities is declared.
+func matchesClientWorkload(request policyEvaluationRequest, workload aembit.ClientWorkloadExternalDTO) bool {
+	if len(request.clientWorkloadID) > 0 {
+		return workload.ExternalID == request.clientWorkloadID
+	}
+	if len(workload.Identities) == 0 {
+		return false
+	}
+	for _, identity := range workload.Identities {
+		declared := false
+		for _, requested := range request.clientIdentities {
+			declared = declared || requested == identity
+		}
+		if !declared {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesServerWorkload returns true if the Server Workload is the declared one, or its service endpoint is the target.
+func matchesServerWorkload(request policyEvaluationRequest, workload aembit.ServerWorkloadExternalDTO) bool {
+	if len(request.serverWorkloadID) > 0 {
+		return workload.ExternalID == request.serverWorkloadID
+	}
+	endpoint := workload.ServiceEndpoint
+	return strings.EqualFold(endpoint.Host, request.targetHost) &&
+		endpoint.Port == request.targetPort &&
+		strings.EqualFold(endpoint.TransportProtocol, request.transportProtocol)
+}
+
+// matchesTrustProvider returns true if each match rule attribute of the Trust Provider is claimed with one of its
+// values. Match rules of the same attribute are alternatives.
+func matchesTrustProvider(claims map[string]string, trustProvider aembit.TrustProviderDTO) bool {
+	matched := make(map[string]bool)
+	for _, rule := range trustProvider.MatchRules {
+		claim, ok := claims[rule.Attribute]
+		matched[rule.Attribute] = matched[rule.Attribute] || (ok && claim == rule.Value)
+	}
+	for _, ok := range matched {
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// stringOrNull returns the value, or null if it is empty.
+func stringOrNull(value string) types.String {
+	if len(value) == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
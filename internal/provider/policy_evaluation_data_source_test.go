@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"aembit.io/aembit"
+)
+
+// newPolicyEvaluationEntities returns an active Access Policy between a Kubernetes Client Workload and a Server
+// Workload, with a Terraform Workspace Trust Provider and an Access Condition.
+func newPolicyEvaluationEntities() policyEvaluationEntities {
+	return policyEvaluationEntities{
+		clientWorkloads: []aembit.ClientWorkloadExternalDTO{{
+			EntityDTO: aembit.EntityDTO{ExternalID: "cw", IsActive: true},
+			Identities: []aembit.ClientWorkloadIdentityDTO{
+				{Type: "k8sNamespace", Value: "deploy"},
+				{Type: "k8sPodNamePrefix", Value: "runner"},
+			},
+		}},
+		serverWorkloads: []aembit.ServerWorkloadExternalDTO{{
+			EntityDTO:       aembit.EntityDTO{ExternalID: "sw", IsActive: true},
+			ServiceEndpoint: aembit.WorkloadServiceEndpointDTO{Host: "postgres.example.com", Port: 5432, TransportProtocol: "TCP"},
+		}},
+		trustProviders: []aembit.TrustProviderDTO{{
+			EntityDTO: aembit.EntityDTO{ExternalID: "tp", IsActive: true},
+			MatchRules: []aembit.TrustProviderMatchRuleDTO{
+				{Attribute: "TerraformOrganizationId", Value: "org"},
+				{Attribute: "TerraformWorkspaceId", Value: "ws-1"},
+				{Attribute: "TerraformWorkspaceId", Value: "ws-2"},
+			},
+		}},
+		accessPolicies: []aembit.PolicyExternalDTO{{
+			EntityDTO:        aembit.EntityDTO{ExternalID: "ap", IsActive: true},
+			ClientWorkload:   aembit.EntityDTO{ExternalID: "cw"},
+			ServerWorkload:   aembit.EntityDTO{ExternalID: "sw"},
+			TrustProviders:   []aembit.EntityDTO{{ExternalID: "tp"}},
+			AccessConditions: []aembit.EntityDTO{{ExternalID: "ac", IsActive: true}},
+		}},
+	}
+}
+
+// newPolicyEvaluationRequest returns a request which the Access Policy of newPolicyEvaluationEntities allows.
+func newPolicyEvaluationRequest() policyEvaluationRequest {
+	return policyEvaluationRequest{
+		clientIdentities: []aembit.ClientWorkloadIdentityDTO{
+			{Type: "k8sPodNamePrefix", Value: "runner"},
+			{Type: "k8sNamespace", Value: "deploy"},
+			{Type: "k8sServiceAccountName", Value: "terraform"},
+		},
+		targetHost:             "Postgres.example.com",
+		targetPort:             5432,
+		transportProtocol:      "TCP",
+		claims:                 map[string]string{"TerraformOrganizationId": "org", "TerraformWorkspaceId": "ws-2"},
+		passedAccessConditions: []string{"ac"},
+	}
+}
+
+func TestEvaluatePolicy(t *testing.T) {
+	result := evaluatePolicy(newPolicyEvaluationRequest(), newPolicyEvaluationEntities())
+	if !result.allowed || result.policyID != "ap" || result.clientWorkloadID != "cw" || result.serverWorkloadID != "sw" {
+		t.Errorf("evaluatePolicy = %+v, want access granted by ap", result)
+	}
+
+	request := policyEvaluationRequest{clientWorkloadID: "cw", serverWorkloadID: "sw", claims: map[string]string{"TerraformOrganizationId": "org", "TerraformWorkspaceId": "ws-1"}, passedAccessConditions: []string{"ac"}}
+	if result = evaluatePolicy(request, newPolicyEvaluationEntities()); !result.allowed {
+		t.Errorf("evaluatePolicy by Workload identifiers = %+v, want access granted", result)
+	}
+
+	// Without active Trust Providers and Access Conditions, the Access Policy needs neither claims nor passed conditions
+	entities := newPolicyEvaluationEntities()
+	entities.trustProviders[0].IsActive = false
+	entities.accessPolicies[0].AccessConditions[0].IsActive = false
+	request = newPolicyEvaluationRequest()
+	request.claims, request.passedAccessConditions = nil, nil
+	if result = evaluatePolicy(request, entities); !result.allowed {
+		t.Errorf("evaluatePolicy without active Trust Providers = %+v, want access granted", result)
+	}
+}
+
+func TestEvaluatePolicyDenied(t *testing.T) {
+	cases := map[string]struct {
+		request  func(*policyEvaluationRequest)
+		entities func(*policyEvaluationEntities)
+		policyID string
+		reason   string
+	}{
+		"undeclared identity": {
+			request: func(r *policyEvaluationRequest) { r.clientIdentities = r.clientIdentities[:1] },
+			reason:  "No Client Workload matches",
+		},
+		"other port": {
+			request: func(r *policyEvaluationRequest) { r.targetPort = 5433 },
+			reason:  "No Server Workload matches",
+		},
+		"other transport": {
+			request: func(r *policyEvaluationRequest) { r.transportProtocol = "UDP" },
+			reason:  "No Server Workload matches",
+		},
+		"no policy": {
+			entities: func(e *policyEvaluationEntities) { e.accessPolicies[0].ServerWorkload.ExternalID = "other" },
+			reason:   "No Access Policy grants",
+		},
+		"inactive policy": {
+			entities: func(e *policyEvaluationEntities) { e.accessPolicies[0].IsActive = false },
+			policyID: "ap",
+			reason:   "Access Policy ap is not active",
+		},
+		"inactive server workload": {
+			entities: func(e *policyEvaluationEntities) { e.serverWorkloads[0].IsActive = false },
+			policyID: "ap",
+			reason:   "Server Workload sw of Access Policy ap is not active",
+		},
+		"unmatched claim": {
+			request:  func(r *policyEvaluationRequest) { r.claims["TerraformWorkspaceId"] = "ws-3" },
+			policyID: "ap",
+			reason:   "satisfy none of the active Trust Providers tp",
+		},
+		"missing claim": {
+			request:  func(r *policyEvaluationRequest) { delete(r.claims, "TerraformOrganizationId") },
+			policyID: "ap",
+			reason:   "satisfy none of the active Trust Providers tp",
+		},
+		"access condition": {
+			request:  func(r *policyEvaluationRequest) { r.passedAccessConditions = nil },
+			policyID: "ap",
+			reason:   "Access Condition ac of Access Policy ap is not declared as passed",
+		},
+	}
+	for name, c := range cases {
+		request, entities := newPolicyEvaluationRequest(), newPolicyEvaluationEntities()
+		if c.request != nil {
+			c.request(&request)
+		}
+		if c.entities != nil {
+			c.entities(&entities)
+		}
+
+		result := evaluatePolicy(request, entities)
+		if result.allowed || result.policyID != c.policyID || !strings.Contains(result.reason, c.reason) {
+			t.Errorf("%s: evaluatePolicy = %+v, want access denied by %q because %q", name, result, c.policyID, c.reason)
+		}
+	}
+}
+
+func TestEvaluatePolicyFirstGrant(t *testing.T) {
+	entities := newPolicyEvaluationEntities()
+	denied := entities.accessPolicies[0]
+	denied.ExternalID, denied.IsActive = "inactive", false
+	entities.accessPolicies = append([]aembit.PolicyExternalDTO{denied}, entities.accessPolicies...)
+
+	if result := evaluatePolicy(newPolicyEvaluationRequest(), entities); !result.allowed || result.policyID != "ap" {
+		t.Errorf("evaluatePolicy = %+v, want access granted by ap despite the inactive Access Policy", result)
+	}
+}
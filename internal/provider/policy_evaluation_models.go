@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// policyEvaluationDataSourceModel maps the data source schema.
+type policyEvaluationDataSourceModel struct {
+	ClientWorkloadID       types.String      `tfsdk:"client_workload_id"`
+	ClientIdentities       []identitiesModel `tfsdk:"client_identities"`
+	ServerWorkloadID       types.String      `tfsdk:"server_workload_id"`
+	TargetHost             types.String      `tfsdk:"target_host"`
+	TargetPort             types.Int64       `tfsdk:"target_port"`
+	TransportProtocol      types.String      `tfsdk:"transport_protocol"`
+	TrustProviderClaims    types.Map         `tfsdk:"trust_provider_claims"`
+	PassedAccessConditions types.Set         `tfsdk:"passed_access_conditions"`
+	Allowed                types.Bool        `tfsdk:"allowed"`
+	PolicyID               types.String      `tfsdk:"policy_id"`
+	MatchedClientWorkload  types.String      `tfsdk:"matched_client_workload_id"`
+	MatchedServerWorkload  types.String      `tfsdk:"matched_server_workload_id"`
+	Reason                 types.String      `tfsdk:"reason"`
+}
@@ -17,13 +17,16 @@ import (
 	"strings"
 	"time"
 
-	"aembit.io/aembit"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"google.golang.org/grpc"
@@ -31,7 +34,11 @@ import (
 )
 
 // Ensure AembitProvider satisfies various provider interfaces.
-var _ provider.Provider = &aembitProvider{}
+var (
+	_ provider.Provider                       = &aembitProvider{}
+	_ provider.ProviderWithEphemeralResources = &aembitProvider{}
+	_ provider.ProviderWithFunctions          = &aembitProvider{}
+)
 
 // New is a helper function to simplify provider server and testing implementation.
 func New(version string) func() provider.Provider {
@@ -44,9 +51,27 @@ func New(version string) func() provider.Provider {
 
 // aembitProviderModel maps provider schema data to a Go type.
 type aembitProviderModel struct {
-	Tenant   types.String `tfsdk:"tenant"`
-	Token    types.String `tfsdk:"token"`
-	ClientID types.String `tfsdk:"client_id"`
+	Tenant       types.String `tfsdk:"tenant"`
+	Token        types.String `tfsdk:"token"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ReportEvents types.Bool   `tfsdk:"report_events"`
+	ReadCache    types.Bool   `tfsdk:"read_cache"`
+	PageSize     types.Int64  `tfsdk:"page_size"`
+}
+
+// aembitEdgeClient holds the Trust Provider attestation configuration used by ephemeral resources
+// to retrieve credentials directly from Aembit Edge.
+type aembitEdgeClient struct {
+	ClientID    string
+	StackDomain string
+}
+
+// aembitProviderData is passed to the resources, data sources and ephemeral resources when the provider is configured.
+type aembitProviderData struct {
+	client *CloudClient
+	edge   *aembitEdgeClient
+	cache  *readCache
+	events *eventRecorder
 }
 
 // AembitProvider defines the provider implementation.
@@ -80,6 +105,24 @@ func (p *aembitProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"report_events": schema.BoolAttribute{
+				Description: "Report each Terraform change to an Aembit entity as an event in the Aembit Tenant, including the Terraform and HCP Terraform run details. " +
+					"Requires `client_id` authentication. May also be set with the `AEMBIT_REPORT_EVENTS` environment variable.",
+				Optional: true,
+			},
+			"read_cache": schema.BoolAttribute{
+				Description: "Read each type of Aembit entity with a single list request per Terraform run, and serve the refresh of every resource of that type from it. " +
+					"Recommended for configurations with many Aembit resources. May also be set with the `AEMBIT_READ_CACHE` environment variable.",
+				Optional: true,
+			},
+			"page_size": schema.Int64Attribute{
+				Description: "Number of Aembit entities to request per page when listing entities. Every list is read page by page until all entities have been read. " +
+					"Defaults to `100`. May also be set with the `AEMBIT_PAGE_SIZE` environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -157,7 +200,7 @@ func (p *aembitProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		if err == nil {
 			aembitToken, err := getAembitToken(aembitClientID, stackDomain, idToken)
 			if err == nil {
-				roleToken, err := getAembitCredential(fmt.Sprintf("%s.api.%s", getAembitTenantId(aembitClientID), stackDomain), 443, aembitClientID, stackDomain, idToken, aembitToken)
+				roleToken, err := getAembitCredential(ctx, fmt.Sprintf("%s.api.%s", getAembitTenantId(aembitClientID), stackDomain), 443, "TCP", aembitClientID, stackDomain, idToken, aembitToken)
 				if err == nil {
 					token = roleToken
 				} else {
@@ -200,6 +243,18 @@ func (p *aembitProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
+	pageSize, err := getPageSize()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("page_size"),
+			"Invalid Aembit Page Size",
+			"The provider cannot list Aembit entities with the page size of the AEMBIT_PAGE_SIZE environment variable: "+err.Error(),
+		)
+	}
+	if !config.PageSize.IsNull() {
+		pageSize = int(config.PageSize.ValueInt64())
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -211,7 +266,7 @@ func (p *aembitProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	tflog.Debug(ctx, "Creating Aembit client")
 
 	// Create a new Aembit client using the configuration values
-	client, err := aembit.NewClient(aembit.URLBuilder{}, &token, p.version)
+	client, err := newCloudClient(tenant, stackDomain, token, p.version, pageSize)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Aembit API Client",
@@ -221,13 +276,39 @@ func (p *aembitProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 		return
 	}
-	client.Tenant = tenant
-	client.StackDomain = stackDomain
 
 	// Make the Aembit client available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	edge := &aembitEdgeClient{ClientID: aembitClientID, StackDomain: stackDomain}
+	data := &aembitProviderData{client: client, edge: edge, cache: &readCache{}}
+	resp.DataSourceData = data
+	resp.ResourceData = data
+	resp.EphemeralResourceData = data
+
+	// Terraform change events are reported through Aembit Edge, so require Trust Provider authentication
+	reportEvents := os.Getenv("AEMBIT_REPORT_EVENTS") == "true"
+	if !config.ReportEvents.IsNull() {
+		reportEvents = config.ReportEvents.ValueBool()
+	}
+	if reportEvents {
+		if len(aembitClientID) > 0 {
+			data.events = newEventRecorder(edge, p.version)
+		} else {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("report_events"),
+				"Aembit Event Reporting Disabled",
+				"Reporting Terraform change events requires the client_id provider configuration or the AEMBIT_CLIENT_ID environment variable.",
+			)
+		}
+	}
+
+	readCache := os.Getenv("AEMBIT_READ_CACHE") == "true"
+	if !config.ReadCache.IsNull() {
+		readCache = config.ReadCache.ValueBool()
+	}
+	if readCache {
+		data.cache.enable()
+	}
 
 	tflog.Info(ctx, fmt.Sprintf("Configured Aembit client (%s)", p.version), map[string]any{"success": true})
 }
@@ -242,20 +323,49 @@ func (p *aembitProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewAccessConditionResource,
 		NewAccessPolicyResource,
 		NewAgentControllerResource,
+		NewAgentControllerDeviceCodeResource,
+		NewAutomationIdentityResource,
+		NewWorkloadCertificateResource,
 	}
 }
 
 func (p *aembitProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewServerWorkloadsDataSource,
+		NewServerWorkloadDataSource,
 		NewCredentialProvidersDataSource,
+		NewCredentialProviderDataSource,
 		NewTrustProvidersDataSource,
+		NewTrustProviderDataSource,
 		NewClientWorkloadsDataSource,
+		NewClientWorkloadDataSource,
+		NewClientIDDataSource,
 		NewIntegrationsDataSource,
+		NewIntegrationDataSource,
 		NewAccessConditionsDataSource,
+		NewAccessConditionDataSource,
 		NewAccessPoliciesDataSource,
+		NewAccessPolicyDataSource,
 		NewAgentControllersDataSource,
+		NewAgentControllerDataSource,
 		NewAgentControllerDeviceCodeDataSource,
+		NewPolicyEvaluationDataSource,
+		NewEdgeConfigurationDataSource,
+	}
+}
+
+func (p *aembitProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewParseClientIDFunction,
+		NewTenantURLsFunction,
+		NewSnowflakeAlterUserFunction,
+	}
+}
+
+func (p *aembitProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewCredentialEphemeralResource,
+		NewCredentialsEphemeralResource,
 	}
 }
 
@@ -267,7 +377,7 @@ var AEMBIT_TOKEN string
 
 type ClientRequestNetwork struct {
 	TargetHost        string `json:"targetHost"`
-	TargetPort        int16  `json:"targetPort"`
+	TargetPort        int32  `json:"targetPort"`
 	TransportProtocol string `json:"transportProtocol"`
 }
 
@@ -301,28 +411,33 @@ func (tokenAuth) RequireTransportSecurity() bool {
 	return true
 }
 
-func getAembitCredential(targetHost string, targetPort int16, clientId, stackDomain, idToken, aembitToken string) (string, error) {
+func getAembitCredential(ctx context.Context, targetHost string, targetPort int32, transportProtocol, clientId, stackDomain, idToken, aembitToken string) (string, error) {
 	var err error
-	var clientRequest, workloadAssessment string
+	var workloadAssessment string
 	var conn *grpc.ClientConn
-	var aembitClient EdgeCommanderClient
-	var credResponse *CredentialResponse
 
-	tlsCreds := credentials.NewTLS(&tls.Config{InsecureSkipVerify: false})
-	if conn, err = grpc.Dial(fmt.Sprintf("%s.ec.%s:443", getAembitTenantId(clientId), stackDomain), grpc.WithTransportCredentials(tlsCreds), grpc.WithPerRPCCredentials(tokenAuth{token: aembitToken})); err != nil {
+	if conn, err = dialEdgeCommander(clientId, stackDomain, aembitToken); err != nil {
 		return "", err
 	}
 	defer conn.Close()
 
-	if clientRequest, err = getClientRequest(targetHost, targetPort); err != nil {
+	if workloadAssessment, err = getWorkloadAssessment(clientId, idToken); err != nil {
 		return "", err
 	}
-	if workloadAssessment, err = getWorkloadAssessment(clientId, idToken); err != nil {
+
+	return requestAembitCredential(ctx, NewEdgeCommanderClient(conn), ClientRequestNetwork{TargetHost: targetHost, TargetPort: targetPort, TransportProtocol: transportProtocol}, workloadAssessment)
+}
+
+func requestAembitCredential(ctx context.Context, aembitClient EdgeCommanderClient, target ClientRequestNetwork, workloadAssessment string) (string, error) {
+	var err error
+	var clientRequest string
+	var credResponse *CredentialResponse
+
+	if clientRequest, err = getClientRequest(target.TargetHost, target.TargetPort, target.TransportProtocol); err != nil {
 		return "", err
 	}
 
-	aembitClient = NewEdgeCommanderClient(conn)
-	if credResponse, err = aembitClient.GetCredential(context.Background(), &CredentialRequest{
+	if credResponse, err = aembitClient.GetCredential(ctx, &CredentialRequest{
 		ClientRequest:      clientRequest,
 		AgentAssessment:    workloadAssessment,
 		WorkloadAssessment: workloadAssessment,
@@ -333,10 +448,15 @@ func getAembitCredential(targetHost string, targetPort int16, clientId, stackDom
 	return credResponse.Credential, nil
 }
 
-func getClientRequest(targetHost string, targetPort int16) (string, error) {
+func dialEdgeCommander(clientId, stackDomain, aembitToken string) (*grpc.ClientConn, error) {
+	tlsCreds := credentials.NewTLS(&tls.Config{InsecureSkipVerify: false})
+	return grpc.NewClient(fmt.Sprintf("%s.ec.%s:443", getAembitTenantId(clientId), stackDomain), grpc.WithTransportCredentials(tlsCreds), grpc.WithPerRPCCredentials(tokenAuth{token: aembitToken}))
+}
+
+func getClientRequest(targetHost string, targetPort int32, transportProtocol string) (string, error) {
 	var request []byte
 	var err error
-	var clientRequest ClientRequest = ClientRequest{Version: "1.0.0", Network: ClientRequestNetwork{TargetHost: targetHost, TargetPort: targetPort, TransportProtocol: "TCP"}}
+	var clientRequest ClientRequest = ClientRequest{Version: "1.0.0", Network: ClientRequestNetwork{TargetHost: targetHost, TargetPort: targetPort, TransportProtocol: transportProtocol}}
 
 	if request, err = json.Marshal(clientRequest); err != nil {
 		return "", err
@@ -345,11 +465,15 @@ func getClientRequest(targetHost string, targetPort int16) (string, error) {
 }
 
 func getWorkloadAssessment(clientId, idToken string) (string, error) {
+	return getWorkloadAssessmentForType(getAembitIdentityType(clientId), idToken)
+}
+
+func getWorkloadAssessmentForType(identityType, idToken string) (string, error) {
 	var assessment []byte
 	var err error
 	var workload WorkloadAssessment
 
-	switch getAembitIdentityType(clientId) {
+	switch identityType {
 	case "gcp_idtoken":
 		workload = WorkloadAssessment{Version: "1.0.0", GCP: WorkloadAssessmentIdToken{IdentityToken: idToken}}
 	case "github_idtoken":
@@ -357,7 +481,7 @@ func getWorkloadAssessment(clientId, idToken string) (string, error) {
 	case "terraform_idtoken":
 		workload = WorkloadAssessment{Version: "1.0.0", Terraform: WorkloadAssessmentIdToken{IdentityToken: idToken}}
 	default:
-		return "", fmt.Errorf("invalid aembit client id")
+		return "", fmt.Errorf("invalid aembit identity type %q", identityType)
 	}
 
 	if assessment, err = json.Marshal(workload); err != nil {
@@ -482,10 +606,16 @@ func getGitHubIdentityToken(clientId, stackDomain string) (string, error) {
 		return "", fmt.Errorf("github action not configured for id_token access")
 	}
 
-	audience := fmt.Sprintf("https://%s.id.%s", getAembitTenantId(clientId), stackDomain)
-	identityTokenURL := fmt.Sprintf("%s&audience=%s", tokenRequestURL, url.QueryEscape(audience))
+	// GitHub Enterprise Server runners provide a request URL on the GHES host, which may come without a query string.
+	identityTokenURL, err := url.Parse(tokenRequestURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse github id token request url: %w", err)
+	}
+	query := identityTokenURL.Query()
+	query.Set("audience", fmt.Sprintf("https://%s.id.%s", getAembitTenantId(clientId), stackDomain))
+	identityTokenURL.RawQuery = query.Encode()
 
-	req, err := http.NewRequest("GET", identityTokenURL, nil)
+	req, err := http.NewRequest("GET", identityTokenURL.String(), nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to create http request: %w", err)
 	}
@@ -497,6 +627,9 @@ func getGitHubIdentityToken(clientId, stackDomain string) (string, error) {
 		return "", fmt.Errorf("failed to fetch github id token: %w", err)
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to fetch github id token: %s", resp.Status)
+	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -511,7 +644,7 @@ func getGitHubIdentityToken(clientId, stackDomain string) (string, error) {
 
 	GITHUB_ID_TOKEN, ok := jsonBody["value"].(string)
 	if !ok {
-		return "", fmt.Errorf("failed to parse response value: %w", err)
+		return "", fmt.Errorf("failed to parse response value")
 	}
 	return GITHUB_ID_TOKEN, nil
 }
@@ -543,6 +676,15 @@ func getAembitIdentityType(clientId string) string {
 	return ""
 }
 
+func getAembitClientIdentifier(clientId string) string {
+	clientIdSplit := strings.Split(clientId, ":")
+	if len(clientIdSplit) >= 6 {
+		return clientIdSplit[5]
+	}
+
+	return ""
+}
+
 func isTokenValid(jwtToken string) bool {
 	var payload []byte
 	var expClaim float64
@@ -4,6 +4,13 @@
 package provider
 
 import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
@@ -15,3 +22,44 @@ import (
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"aembit": providerserver.NewProtocol6WithError(New("test")()),
 }
+
+func TestGetGitHubIdentityToken(t *testing.T) {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp": %d}`, time.Now().Add(time.Hour).Unix())))
+	token := "eyJhbGciOiJSUzI1NiJ9." + payload + ".signature"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer request-token" || r.URL.Query().Get("audience") != "https://tenant.id.example.com" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"value": %q}`, token)
+	}))
+	defer server.Close()
+
+	// GitHub Enterprise Server request URL without a query string.
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", server.URL+"/_services/pipelines/idtoken")
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "request-token")
+	identityToken, err := getGitHubIdentityToken("aembit:example.com:tenant:identity:github_idtoken:id", "example.com")
+	if err != nil || identityToken != token {
+		t.Fatalf("expected the identity token, got %s: %v", identityToken, err)
+	}
+
+	// Each call requests a new identity token, as GitHub issues them per audience and request.
+	server.Close()
+	if _, err = getGitHubIdentityToken("aembit:example.com:tenant:identity:github_idtoken:id", "example.com"); err == nil {
+		t.Errorf("expected a new identity token to be requested")
+	}
+}
+
+func TestGetGitHubIdentityToken_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	// github.com request URL with a query string.
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", server.URL+"/idtoken?api-version=2.0")
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "request-token")
+	if _, err := getGitHubIdentityToken("aembit:example.com:tenant:identity:github_idtoken:id", "example.com"); err == nil {
+		t.Errorf("expected an error for a forbidden request")
+	}
+}
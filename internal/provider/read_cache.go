@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"aembit.io/aembit"
+)
+
+// readCache serves the Read of each Aembit entity from a single list request per entity type. Terraform reads
+// resources in parallel, so the first Read of an entity type loads the list and concurrent Reads wait for it.
+// Each configured provider has its own cache, enabled by the provider read_cache setting. A nil cache is disabled.
+type readCache struct {
+	mutex   sync.Mutex
+	enabled bool
+	entries map[string]*readCacheEntry
+}
+
+// readCacheEntry holds the loaded list of one entity type, in API order and keyed by external ID.
+type readCacheEntry struct {
+	once  sync.Once
+	items any
+	byID  map[string]any
+	err   error
+}
+
+// enable starts caching entity lists.
+func (c *readCache) enable() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.enabled = true
+	c.entries = make(map[string]*readCacheEntry)
+}
+
+// entry returns the cache entry of the entity type, or nil if the cache is disabled.
+func (c *readCache) entry(entityType string) *readCacheEntry {
+	if c == nil {
+		return nil
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if !c.enabled {
+		return nil
+	}
+	entry, ok := c.entries[entityType]
+	if !ok {
+		entry = &readCacheEntry{}
+		c.entries[entityType] = entry
+	}
+	return entry
+}
+
+// invalidate drops the cached list of the entity type, so that the next Read loads it again. It is deferred by every
+// write, as a failed request may still have changed the entity.
+func (c *readCache) invalidate(entityType string) {
+	if c == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.entries, entityType)
+}
+
+// load lists the entities of the entry once. Concurrent callers wait for the first list request to complete.
+func load[T any](entry *readCacheEntry, list func(h http.Header) ([]T, error), entity func(T) aembit.EntityDTO) ([]T, error) {
+	entry.once.Do(func() {
+		items, err := list(nil)
+		if err != nil {
+			entry.err = err
+			return
+		}
+		entry.items = items
+		entry.byID = make(map[string]any, len(items))
+		for _, item := range items {
+			entry.byID[entity(item).ExternalID] = item
+		}
+	})
+	if entry.err != nil {
+		return nil, entry.err
+	}
+
+	items, ok := entry.items.([]T)
+	if !ok {
+		return nil, fmt.Errorf("unexpected cached list type %T", entry.items)
+	}
+	return items, nil
+}
+
+// cachedList returns the entities of a type, loading them with list once until the cache is invalidated.
+func cachedList[T any](c *readCache, entityType string, list func(h http.Header) ([]T, error), entity func(T) aembit.EntityDTO) ([]T, error) {
+	entry := c.entry(entityType)
+	if entry == nil {
+		return list(nil)
+	}
+	return load(entry, list, entity)
+}
+
+// cachedGet returns a single entity from the cached list of its type. It falls back to get if the cache is disabled,
+// the list could not be loaded, or the entity is not in the list.
+func cachedGet[T any](c *readCache, entityType, id string, list func(h http.Header) ([]T, error), get func(id string, h http.Header) (T, error), entity func(T) aembit.EntityDTO) (T, error) {
+	if entry := c.entry(entityType); entry != nil {
+		if _, err := load(entry, list, entity); err == nil {
+			if item, ok := entry.byID[id].(T); ok {
+				return item, nil
+			}
+		}
+	}
+	return get(id, nil)
+}
+
+// cachedFind looks up a single entity in the cached list of its type. Unlike cachedGet, it distinguishes an entity
+// which no longer exists, reported as not found, from a failure to list the entities.
+func cachedFind[T any](c *readCache, entityType, id string, list func(h http.Header) ([]T, error), entity func(T) aembit.EntityDTO) (T, bool, error) {
+	var found T
+	items, err := cachedList(c, entityType, list, entity)
+	if err != nil {
+		return found, false, err
+	}
+	for _, item := range items {
+		if entity(item).ExternalID == id {
+			return item, true, nil
+		}
+	}
+	return found, false, nil
+}
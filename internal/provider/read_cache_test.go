@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"aembit.io/aembit"
+)
+
+func TestReadCache(t *testing.T) {
+	var listCalls, getCalls atomic.Int32
+	list := func(_ http.Header) ([]aembit.EntityDTO, error) {
+		listCalls.Add(1)
+		return []aembit.EntityDTO{{ExternalID: "1", Name: "first"}, {ExternalID: "2", Name: "second"}}, nil
+	}
+	get := func(id string, _ http.Header) (aembit.EntityDTO, error) {
+		getCalls.Add(1)
+		return aembit.EntityDTO{ExternalID: id, Name: "fetched"}, nil
+	}
+	entity := func(e aembit.EntityDTO) aembit.EntityDTO { return e }
+
+	// Disabled, every Read calls get.
+	var cache readCache
+	if item, err := cachedGet(&cache, "server_workload", "1", list, get, entity); err != nil || item.Name != "fetched" {
+		t.Fatalf("expected get to be called, got %v (%v)", item, err)
+	}
+	if listCalls.Load() != 0 || getCalls.Load() != 1 {
+		t.Fatalf("unexpected calls, list %d get %d", listCalls.Load(), getCalls.Load())
+	}
+
+	// Enabled, parallel Reads share a single list request.
+	cache.enable()
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if item, err := cachedGet(&cache, "server_workload", "2", list, get, entity); err != nil || item.Name != "second" {
+				t.Errorf("expected the cached entity, got %v (%v)", item, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if listCalls.Load() != 1 || getCalls.Load() != 1 {
+		t.Fatalf("unexpected calls, list %d get %d", listCalls.Load(), getCalls.Load())
+	}
+
+	// Entities missing from the list fall back to get.
+	if item, err := cachedGet(&cache, "server_workload", "3", list, get, entity); err != nil || item.Name != "fetched" {
+		t.Errorf("expected get to be called for a missing entity, got %v (%v)", item, err)
+	}
+
+	// The list keeps the API order.
+	items, err := cachedList(&cache, "server_workload", list, entity)
+	if err != nil || len(items) != 2 || items[0].ExternalID != "1" || items[1].ExternalID != "2" {
+		t.Errorf("unexpected cached list %v (%v)", items, err)
+	}
+
+	// Writes invalidate the list.
+	cache.invalidate("server_workload")
+	if _, err := cachedList(&cache, "server_workload", list, entity); err != nil || listCalls.Load() != 2 {
+		t.Errorf("expected the list to be loaded again, got %d list calls (%v)", listCalls.Load(), err)
+	}
+}
+
+func TestReadCacheScope(t *testing.T) {
+	var listCalls atomic.Int32
+	list := func(_ http.Header) ([]aembit.EntityDTO, error) {
+		listCalls.Add(1)
+		return []aembit.EntityDTO{{ExternalID: "1", Name: "first"}}, nil
+	}
+	entity := func(e aembit.EntityDTO) aembit.EntityDTO { return e }
+
+	// Each configured provider loads its own lists.
+	var first, second readCache
+	first.enable()
+	second.enable()
+	for _, cache := range []*readCache{&first, &first, &second} {
+		if _, err := cachedList(cache, "server_workload", list, entity); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if listCalls.Load() != 2 {
+		t.Errorf("expected one list request per provider, got %d", listCalls.Load())
+	}
+
+	// The cache of a resource which has not been configured is disabled.
+	var unconfigured *readCache
+	unconfigured.invalidate("server_workload")
+	if _, err := cachedList(unconfigured, "server_workload", list, entity); err != nil || listCalls.Load() != 3 {
+		t.Errorf("expected the list to be requested, got %d list calls (%v)", listCalls.Load(), err)
+	}
+}
+
+func TestCachedFind(t *testing.T) {
+	list := func(_ http.Header) ([]aembit.EntityDTO, error) {
+		return []aembit.EntityDTO{{ExternalID: "1", Name: "first"}}, nil
+	}
+	entity := func(e aembit.EntityDTO) aembit.EntityDTO { return e }
+
+	var cache readCache
+	if item, found, err := cachedFind(&cache, "server_workload", "1", list, entity); err != nil || !found || item.Name != "first" {
+		t.Errorf("expected the first entity, got %v %t (%v)", item, found, err)
+	}
+	if _, found, err := cachedFind(&cache, "server_workload", "2", list, entity); err != nil || found {
+		t.Errorf("expected a missing entity to not be found, got %t (%v)", found, err)
+	}
+
+	failing := func(_ http.Header) ([]aembit.EntityDTO, error) { return nil, errors.New("unavailable") }
+	if _, _, err := cachedFind(&cache, "server_workload", "1", failing, entity); err == nil {
+		t.Error("expected the list error to be returned")
+	}
+}
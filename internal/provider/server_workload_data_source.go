@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"aembit.io/aembit"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &serverWorkloadDataSource{}
+	_ datasource.DataSourceWithConfigure = &serverWorkloadDataSource{}
+)
+
+// NewServerWorkloadDataSource is a helper function to simplify the provider implementation.
+func NewServerWorkloadDataSource() datasource.DataSource {
+	return &serverWorkloadDataSource{}
+}
+
+// serverWorkloadDataSource is the data source implementation.
+type serverWorkloadDataSource struct {
+	client *CloudClient
+	cache  *readCache
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *serverWorkloadDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*aembitProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+	d.cache = data.cache
+}
+
+// Metadata returns the data source type name.
+func (d *serverWorkloadDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_workload"
+}
+
+// Schema defines the schema for the data source.
+func (d *serverWorkloadDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema, resp.Diagnostics = entitySchema(ctx, NewServerWorkloadsDataSource(), "server_workloads", "Looks up a single server workload by id or name.", true)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *serverWorkloadDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	id, name, diags := getEntityLookup(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverWorkloads, err := cachedList(d.cache, "server_workload", d.client.GetServerWorkloads, func(e aembit.ServerWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO })
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Aembit Server Workloads",
+			err.Error(),
+		)
+		return
+	}
+
+	serverWorkload, err := findEntity(serverWorkloads, id, name, "server workload", func(e aembit.ServerWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO })
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Find Aembit Server Workload",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state := convertServerWorkloadDTOToModel(ctx, serverWorkload)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
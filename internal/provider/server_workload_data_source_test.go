@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServerWorkloadDataSource(t *testing.T) {
+	createFile, _ := os.ReadFile("../../tests/server/TestAccServerWorkloadDataSource.tf")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: string(createFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify lookup by name
+					resource.TestCheckResourceAttrPair("data.aembit_server_workload.by_name", "id", "aembit_server_workload.test", "id"),
+					resource.TestCheckResourceAttr("data.aembit_server_workload.by_name", "service_endpoint.host", "lookup.testhost.com"),
+					// Verify lookup by id
+					resource.TestCheckResourceAttr("data.aembit_server_workload.by_id", "name", "Unit Test Lookup"),
+					resource.TestCheckResourceAttr("data.aembit_server_workload.by_id", "service_endpoint.port", "443"),
+				),
+			},
+		},
+	})
+}
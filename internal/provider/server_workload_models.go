@@ -16,6 +16,10 @@ type serverWorkloadResourceModel struct {
 // serverWorkloadDataSourceModel maps the datasource schema.
 type serverWorkloadsDataSourceModel struct {
 	ServerWorkloads []serverWorkloadResourceModel `tfsdk:"server_workloads"`
+	NameRegex       types.String                  `tfsdk:"name_regex"`
+	Tags            types.Map                     `tfsdk:"tags"`
+	IsActive        types.Bool                    `tfsdk:"is_active"`
+	AppProtocol     types.String                  `tfsdk:"app_protocol"`
 }
 
 // serviceEndpointModel maps service endpoint data.
@@ -27,7 +27,9 @@ func NewServerWorkloadResource() resource.Resource {
 
 // serverWorkloadResource is the resource implementation.
 type serverWorkloadResource struct {
-	client *aembit.CloudClient
+	client *CloudClient
+	cache  *readCache
+	events *eventRecorder
 }
 
 // Metadata returns the resource type name.
@@ -41,18 +43,20 @@ func (r *serverWorkloadResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*aembit.CloudClient)
+	data, ok := req.ProviderData.(*aembitProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *aembit.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.cache = data.cache
+	r.events = data.events
 }
 
 // Schema defines the schema for the resource.
@@ -238,6 +242,8 @@ func (r *serverWorkloadResource) Create(ctx context.Context, req resource.Create
 	// Generate API request body from plan
 	var workload aembit.ServerWorkloadExternalDTO = convertServerWorkloadModelToDTO(ctx, plan, nil)
 
+	defer r.cache.invalidate("server_workload")
+
 	// Create new Server Workload
 	serverWorkload, err := r.client.CreateServerWorkload(workload, nil)
 	if err != nil {
@@ -257,6 +263,8 @@ func (r *serverWorkloadResource) Create(ctx context.Context, req resource.Create
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.events.record(ctx, "server_workload", plan.ID.ValueString(), eventActionCreate)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -270,7 +278,7 @@ func (r *serverWorkloadResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get refreshed workload value from Aembit
-	serverWorkload, err := r.client.GetServerWorkload(state.ID.ValueString(), nil)
+	serverWorkload, err := cachedGet(r.cache, "server_workload", state.ID.ValueString(), r.client.GetServerWorkloads, r.client.GetServerWorkload, func(e aembit.ServerWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO })
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Aembit Server Workload",
@@ -314,6 +322,8 @@ func (r *serverWorkloadResource) Update(ctx context.Context, req resource.Update
 	// Generate API request body from plan
 	var workload aembit.ServerWorkloadExternalDTO = convertServerWorkloadModelToDTO(ctx, plan, &externalID)
 
+	defer r.cache.invalidate("server_workload")
+
 	// Update Server Workload
 	serverWorkload, err := r.client.UpdateServerWorkload(workload, nil)
 	if err != nil {
@@ -333,6 +343,8 @@ func (r *serverWorkloadResource) Update(ctx context.Context, req resource.Update
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.events.record(ctx, "server_workload", state.ID.ValueString(), eventActionUpdate)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -345,6 +357,8 @@ func (r *serverWorkloadResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	defer r.cache.invalidate("server_workload")
+
 	// Check if Server Workload is Active - if it is, disable it first
 	if state.IsActive == types.BoolValue(true) {
 		_, err := r.client.DisableServerWorkload(state.ID.ValueString(), nil)
@@ -366,6 +380,8 @@ func (r *serverWorkloadResource) Delete(ctx context.Context, req resource.Delete
 		)
 		return
 	}
+
+	r.events.record(ctx, "server_workload", state.ID.ValueString(), eventActionDelete)
 }
 
 // Imports an existing resource by passing externalID.
@@ -23,7 +23,8 @@ func NewServerWorkloadsDataSource() datasource.DataSource {
 
 // serverWorkloadsDataSource is the data source implementation.
 type serverWorkloadsDataSource struct {
-	client *aembit.CloudClient
+	client *CloudClient
+	cache  *readCache
 }
 
 // Configure adds the provider configured client to the data source.
@@ -32,17 +33,18 @@ func (d *serverWorkloadsDataSource) Configure(_ context.Context, req datasource.
 		return
 	}
 
-	client, ok := req.ProviderData.(*aembit.CloudClient)
+	data, ok := req.ProviderData.(*aembitProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *aembit.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.cache = data.cache
 }
 
 // Metadata returns the data source type name.
@@ -157,13 +159,30 @@ func (d *serverWorkloadsDataSource) Schema(_ context.Context, _ datasource.Schem
 			},
 		},
 	}
+
+	addEntityFilterAttributes(resp.Schema.Attributes, "server workloads")
+	resp.Schema.Attributes["app_protocol"] = schema.StringAttribute{
+		Description: "Application Protocol which the service endpoint of the server workloads must have, e.g. `HTTP`.",
+		Optional:    true,
+	}
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *serverWorkloadsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state serverWorkloadsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newEntityFilter(ctx, state.NameRegex, state.Tags, state.IsActive)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	serverWorkloads, err := d.client.GetServerWorkloads(nil)
+	serverWorkloads, err := cachedList(d.cache, "server_workload", d.client.GetServerWorkloads, func(e aembit.ServerWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO })
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Aembit Server Workloads",
@@ -174,12 +193,15 @@ func (d *serverWorkloadsDataSource) Read(ctx context.Context, req datasource.Rea
 
 	// Map response body to model
 	for _, serverWorkload := range serverWorkloads {
+		if !filter.matches(serverWorkload.EntityDTO) || (len(state.AppProtocol.ValueString()) > 0 && serverWorkload.ServiceEndpoint.AppProtocol != state.AppProtocol.ValueString()) {
+			continue
+		}
 		serverWorkloadState := convertServerWorkloadDTOToModel(ctx, serverWorkload)
 		state.ServerWorkloads = append(state.ServerWorkloads, serverWorkloadState)
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &snowflakeAlterUserFunction{}
+
+// NewSnowflakeAlterUserFunction is a helper function to simplify the provider implementation.
+func NewSnowflakeAlterUserFunction() function.Function {
+	return &snowflakeAlterUserFunction{}
+}
+
+// snowflakeAlterUserFunction is the function implementation.
+type snowflakeAlterUserFunction struct{}
+
+// Metadata returns the function name.
+func (f *snowflakeAlterUserFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "snowflake_alter_user"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *snowflakeAlterUserFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build a Snowflake ALTER USER statement",
+		Description: "Builds the Snowflake `ALTER USER` statement which assigns an RSA public key to a user, as required by the Snowflake JWT Credential Provider.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "username",
+				Description: "Snowflake username.",
+			},
+			function.StringParameter{
+				Name:        "public_key",
+				Description: "PEM encoded RSA public key.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the ALTER USER statement.
+func (f *snowflakeAlterUserFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var username, publicKey string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &username, &publicKey))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(username) == 0 {
+		resp.Error = function.NewArgumentFuncError(0, "The username must not be empty")
+		return
+	}
+
+	if _, err := decodePEMBlocks(publicKey); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, "The public key must be PEM encoded: "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, getSnowflakeAlterUserCommand(username, publicKey)))
+}
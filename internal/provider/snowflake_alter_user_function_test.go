@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSnowflakeAlterUserFunction(t *testing.T) {
+	publicKey := "-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A\nMIIBCgKCAQEAs2Kd\n-----END PUBLIC KEY-----\n"
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("svc_user"), types.StringValue(publicKey)}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewSnowflakeAlterUserFunction().Run(context.Background(), req, &resp)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %v", resp.Error)
+	}
+
+	expected := types.StringValue("ALTER USER svc_user SET RSA_PUBLIC_KEY='MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAs2Kd'")
+	if !resp.Result.Value().Equal(expected) {
+		t.Errorf("unexpected result %v", resp.Result.Value())
+	}
+}
+
+func TestSnowflakeAlterUserFunction_PublicKey(t *testing.T) {
+	cases := map[string]struct {
+		publicKey string
+		expected  string
+	}{
+		"crlf line endings": {
+			publicKey: "-----BEGIN PUBLIC KEY-----\r\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A\r\nMIIBCgKCAQEAs2Kd\r\n-----END PUBLIC KEY-----\r\n",
+			expected:  "ALTER USER svc_user SET RSA_PUBLIC_KEY='MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAs2Kd'",
+		},
+		"not pem": {
+			publicKey: "MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAs2Kd",
+		},
+	}
+	for name, c := range cases {
+		req := function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("svc_user"), types.StringValue(c.publicKey)}),
+		}
+		resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+		NewSnowflakeAlterUserFunction().Run(context.Background(), req, &resp)
+		if (resp.Error != nil) != (len(c.expected) == 0) {
+			t.Fatalf("%s: unexpected error: %v", name, resp.Error)
+		}
+		if len(c.expected) > 0 && !resp.Result.Value().Equal(types.StringValue(c.expected)) {
+			t.Errorf("%s: unexpected result %v", name, resp.Result.Value())
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &tenantURLsFunction{}
+
+// NewTenantURLsFunction is a helper function to simplify the provider implementation.
+func NewTenantURLsFunction() function.Function {
+	return &tenantURLsFunction{}
+}
+
+// tenantURLsFunction is the function implementation.
+type tenantURLsFunction struct{}
+
+// tenantURLsFunctionResult maps the function result object.
+type tenantURLsFunctionResult struct {
+	APIURL        types.String `tfsdk:"api_url"`
+	IdentityURL   types.String `tfsdk:"identity_url"`
+	OIDCIssuerURL types.String `tfsdk:"oidc_issuer_url"`
+}
+
+// Metadata returns the function name.
+func (f *tenantURLsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tenant_urls"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *tenantURLsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build the URLs of an Aembit Tenant",
+		Description: "Returns the API, identity and OIDC issuer URLs of an Aembit Tenant.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "tenant",
+				Description: "Tenant ID of the Aembit Cloud instance.",
+			},
+			function.StringParameter{
+				Name:        "stack_domain",
+				Description: "Stack domain of the Aembit Cloud instance, e.g. `useast2.aembit.io`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"api_url":         types.StringType,
+				"identity_url":    types.StringType,
+				"oidc_issuer_url": types.StringType,
+			},
+		},
+	}
+}
+
+// Run builds the Tenant URLs.
+func (f *tenantURLsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tenant, stackDomain string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &tenant, &stackDomain))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(tenant) == 0 {
+		resp.Error = function.NewArgumentFuncError(0, "The tenant must not be empty")
+		return
+	}
+	if len(stackDomain) == 0 {
+		resp.Error = function.NewArgumentFuncError(1, "The stack_domain must not be empty")
+		return
+	}
+
+	result := tenantURLsFunctionResult{
+		APIURL:        types.StringValue(fmt.Sprintf("https://%s.api.%s", tenant, stackDomain)),
+		IdentityURL:   types.StringValue(fmt.Sprintf("https://%s.id.%s", tenant, stackDomain)),
+		OIDCIssuerURL: types.StringValue(fmt.Sprintf("https://%s.id.%s/", tenant, stackDomain)),
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, &result))
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTenantURLsFunction(t *testing.T) {
+	ctx := context.Background()
+	attributeTypes := map[string]attr.Type{
+		"api_url":         types.StringType,
+		"identity_url":    types.StringType,
+		"oidc_issuer_url": types.StringType,
+	}
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("a12bc3"), types.StringValue("useast2.aembit.io")}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(attributeTypes))}
+	NewTenantURLsFunction().Run(ctx, req, &resp)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %v", resp.Error)
+	}
+
+	expected := types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+		"api_url":         types.StringValue("https://a12bc3.api.useast2.aembit.io"),
+		"identity_url":    types.StringValue("https://a12bc3.id.useast2.aembit.io"),
+		"oidc_issuer_url": types.StringValue("https://a12bc3.id.useast2.aembit.io/"),
+	})
+	if !resp.Result.Value().Equal(expected) {
+		t.Errorf("unexpected result %v", resp.Result.Value())
+	}
+
+	req.Arguments = function.NewArgumentsData([]attr.Value{types.StringValue(""), types.StringValue("useast2.aembit.io")})
+	resp = function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(attributeTypes))}
+	NewTenantURLsFunction().Run(ctx, req, &resp)
+	if resp.Error == nil {
+		t.Error("expected an error for an empty tenant")
+	}
+}
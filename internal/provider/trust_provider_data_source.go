@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"aembit.io/aembit"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &trustProviderDataSource{}
+	_ datasource.DataSourceWithConfigure = &trustProviderDataSource{}
+)
+
+// NewTrustProviderDataSource is a helper function to simplify the provider implementation.
+func NewTrustProviderDataSource() datasource.DataSource {
+	return &trustProviderDataSource{}
+}
+
+// trustProviderDataSource is the data source implementation.
+type trustProviderDataSource struct {
+	client *CloudClient
+	cache  *readCache
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *trustProviderDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*aembitProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+	d.cache = data.cache
+}
+
+// Metadata returns the data source type name.
+func (d *trustProviderDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trust_provider"
+}
+
+// Schema defines the schema for the data source.
+func (d *trustProviderDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema, resp.Diagnostics = entitySchema(ctx, NewTrustProvidersDataSource(), "trust_providers", "Looks up a single trust provider by id or name.", true)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *trustProviderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	id, name, diags := getEntityLookup(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	trustProviders, err := cachedList(d.cache, "trust_provider", d.client.GetTrustProviders, func(e aembit.TrustProviderDTO) aembit.EntityDTO { return e.EntityDTO })
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Aembit Trust Providers",
+			err.Error(),
+		)
+		return
+	}
+
+	trustProvider, err := findEntity(trustProviders, id, name, "trust provider", func(e aembit.TrustProviderDTO) aembit.EntityDTO { return e.EntityDTO })
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Find Aembit Trust Provider",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state := convertTrustProviderDTOToModel(ctx, trustProvider, trustProviderResourceModel{})
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
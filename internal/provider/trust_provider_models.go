@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -12,81 +13,210 @@ type trustProviderResourceModel struct {
 	Description        types.String                     `tfsdk:"description"`
 	IsActive           types.Bool                       `tfsdk:"is_active"`
 	Tags               types.Map                        `tfsdk:"tags"`
+	ClientIDType       types.String                     `tfsdk:"client_id_type"`
 	AzureMetadata      *trustProviderAzureMetadataModel `tfsdk:"azure_metadata"`
 	AwsEcsRole         *trustProviderAwsEcsRoleModel    `tfsdk:"aws_ecs_role"`
 	AwsMetadata        *trustProviderAwsMetadataModel   `tfsdk:"aws_metadata"`
+	AwsRole            *trustProviderAwsRoleModel       `tfsdk:"aws_role"`
 	GcpIdentity        *trustProviderGcpIdentityModel   `tfsdk:"gcp_identity"`
 	GitHubAction       *trustProviderGitHubActionModel  `tfsdk:"github_action"`
+	GitLabJob          *trustProviderGitLabJobModel     `tfsdk:"gitlab_job"`
 	Kerberos           *trustProviderKerberosModel      `tfsdk:"kerberos"`
 	KubernetesService  *trustProviderKubernetesModel    `tfsdk:"kubernetes_service_account"`
+	OidcIDToken        *trustProviderOidcIDTokenModel   `tfsdk:"oidc_id_token"`
 	TerraformWorkspace *trustProviderTerraformModel     `tfsdk:"terraform_workspace"`
 }
 
 // trustProviderDataSourceModel maps the datasource schema.
 type trustProvidersDataSourceModel struct {
 	TrustProviders []trustProviderResourceModel `tfsdk:"trust_providers"`
+	NameRegex      types.String                 `tfsdk:"name_regex"`
+	Tags           types.Map                    `tfsdk:"tags"`
+	IsActive       types.Bool                   `tfsdk:"is_active"`
+	Type           types.String                 `tfsdk:"type"`
 }
 
 type trustProviderAzureMetadataModel struct {
-	Sku            types.String `tfsdk:"sku"`
-	VMID           types.String `tfsdk:"vm_id"`
-	SubscriptionID types.String `tfsdk:"subscription_id"`
+	Sku             types.String   `tfsdk:"sku"`
+	Skus            []types.String `tfsdk:"skus"`
+	VMID            types.String   `tfsdk:"vm_id"`
+	VMIDs           []types.String `tfsdk:"vm_ids"`
+	SubscriptionID  types.String   `tfsdk:"subscription_id"`
+	SubscriptionIDs []types.String `tfsdk:"subscription_ids"`
+
+	MatchRules types.Set `tfsdk:"match_rules"`
 }
 
 type trustProviderAwsEcsRoleModel struct {
-	AccountID   types.String `tfsdk:"account_id"`
-	AssumedRole types.String `tfsdk:"assumed_role"`
-	RoleARN     types.String `tfsdk:"role_arn"`
-	Username    types.String `tfsdk:"username"`
+	AccountID    types.String   `tfsdk:"account_id"`
+	AccountIDs   []types.String `tfsdk:"account_ids"`
+	AssumedRole  types.String   `tfsdk:"assumed_role"`
+	AssumedRoles []types.String `tfsdk:"assumed_roles"`
+	RoleARN      types.String   `tfsdk:"role_arn"`
+	RoleARNs     []types.String `tfsdk:"role_arns"`
+	Username     types.String   `tfsdk:"username"`
+	Usernames    []types.String `tfsdk:"usernames"`
+
+	MatchRules types.Set `tfsdk:"match_rules"`
 }
 
 type trustProviderAwsMetadataModel struct {
-	Certificate             types.String `tfsdk:"certificate"`
-	AccountID               types.String `tfsdk:"account_id"`
-	Architecture            types.String `tfsdk:"architecture"`
-	AvailabilityZone        types.String `tfsdk:"availability_zone"`
-	BillingProducts         types.String `tfsdk:"billing_products"`
-	ImageID                 types.String `tfsdk:"image_id"`
-	InstanceID              types.String `tfsdk:"instance_id"`
-	InstanceType            types.String `tfsdk:"instance_type"`
-	KernelID                types.String `tfsdk:"kernel_id"`
-	MarketplaceProductCodes types.String `tfsdk:"marketplace_product_codes"`
-	PendingTime             types.String `tfsdk:"pending_time"`
-	PrivateIP               types.String `tfsdk:"private_ip"`
-	RamdiskID               types.String `tfsdk:"ramdisk_id"`
-	Region                  types.String `tfsdk:"region"`
-	Version                 types.String `tfsdk:"version"`
+	Certificate             pemValue       `tfsdk:"certificate"`
+	CertificateRegion       types.String   `tfsdk:"certificate_region"`
+	AccountID               types.String   `tfsdk:"account_id"`
+	AccountIDs              []types.String `tfsdk:"account_ids"`
+	Architecture            types.String   `tfsdk:"architecture"`
+	Architectures           []types.String `tfsdk:"architectures"`
+	AvailabilityZone        types.String   `tfsdk:"availability_zone"`
+	AvailabilityZones       []types.String `tfsdk:"availability_zones"`
+	BillingProducts         types.String   `tfsdk:"billing_products"`
+	ImageID                 types.String   `tfsdk:"image_id"`
+	ImageIDs                []types.String `tfsdk:"image_ids"`
+	InstanceID              types.String   `tfsdk:"instance_id"`
+	InstanceIDs             []types.String `tfsdk:"instance_ids"`
+	InstanceType            types.String   `tfsdk:"instance_type"`
+	InstanceTypes           []types.String `tfsdk:"instance_types"`
+	KernelID                types.String   `tfsdk:"kernel_id"`
+	KernelIDs               []types.String `tfsdk:"kernel_ids"`
+	MarketplaceProductCodes types.String   `tfsdk:"marketplace_product_codes"`
+	PendingTime             types.String   `tfsdk:"pending_time"`
+	PendingTimes            []types.String `tfsdk:"pending_times"`
+	PrivateIP               types.String   `tfsdk:"private_ip"`
+	PrivateIPs              []types.String `tfsdk:"private_ips"`
+	RamdiskID               types.String   `tfsdk:"ramdisk_id"`
+	RamdiskIDs              []types.String `tfsdk:"ramdisk_ids"`
+	Region                  types.String   `tfsdk:"region"`
+	Regions                 []types.String `tfsdk:"regions"`
+	Version                 types.String   `tfsdk:"version"`
+	Versions                []types.String `tfsdk:"versions"`
+
+	MatchRules types.Set `tfsdk:"match_rules"`
+}
+
+type trustProviderAwsRoleModel struct {
+	AccountID    types.String   `tfsdk:"account_id"`
+	AccountIDs   []types.String `tfsdk:"account_ids"`
+	AssumedRole  types.String   `tfsdk:"assumed_role"`
+	AssumedRoles []types.String `tfsdk:"assumed_roles"`
+	RoleARN      types.String   `tfsdk:"role_arn"`
+	RoleARNs     []types.String `tfsdk:"role_arns"`
+	Username     types.String   `tfsdk:"username"`
+	Usernames    []types.String `tfsdk:"usernames"`
+
+	MatchRules types.Set `tfsdk:"match_rules"`
 }
 
 type trustProviderKerberosModel struct {
 	AgentControllerIDs []types.String `tfsdk:"agent_controller_ids"`
 	Principal          types.String   `tfsdk:"principal"`
+	Principals         []types.String `tfsdk:"principals"`
 	Realm              types.String   `tfsdk:"realm"`
+	Realms             []types.String `tfsdk:"realms"`
 	SourceIP           types.String   `tfsdk:"source_ip"`
+	SourceIPs          []types.String `tfsdk:"source_ips"`
+
+	MatchRules types.Set `tfsdk:"match_rules"`
 }
 
 type trustProviderKubernetesModel struct {
-	Issuer             types.String `tfsdk:"issuer"`
-	Namespace          types.String `tfsdk:"namespace"`
-	PodName            types.String `tfsdk:"pod_name"`
-	ServiceAccountName types.String `tfsdk:"service_account_name"`
-	Subject            types.String `tfsdk:"subject"`
-	OIDCEndpoint       types.String `tfsdk:"oidc_endpoint"`
-	PublicKey          types.String `tfsdk:"public_key"`
+	Issuer              types.String   `tfsdk:"issuer"`
+	Issuers             []types.String `tfsdk:"issuers"`
+	Namespace           types.String   `tfsdk:"namespace"`
+	Namespaces          []types.String `tfsdk:"namespaces"`
+	PodName             types.String   `tfsdk:"pod_name"`
+	PodNames            []types.String `tfsdk:"pod_names"`
+	ServiceAccountName  types.String   `tfsdk:"service_account_name"`
+	ServiceAccountNames []types.String `tfsdk:"service_account_names"`
+	Subject             types.String   `tfsdk:"subject"`
+	Subjects            []types.String `tfsdk:"subjects"`
+	OIDCEndpoint        types.String   `tfsdk:"oidc_endpoint"`
+	PublicKey           pemValue       `tfsdk:"public_key"`
+	Jwks                types.String   `tfsdk:"jwks"`
+
+	MatchRules types.Set `tfsdk:"match_rules"`
+}
+
+type trustProviderOidcIDTokenModel struct {
+	Issuer    types.String   `tfsdk:"issuer"`
+	JwksURL   types.String   `tfsdk:"jwks_url"`
+	PublicKey pemValue       `tfsdk:"public_key"`
+	Audience  types.String   `tfsdk:"audience"`
+	Audiences []types.String `tfsdk:"audiences"`
+	Subject   types.String   `tfsdk:"subject"`
+	Subjects  []types.String `tfsdk:"subjects"`
+	Claims    types.Map      `tfsdk:"claims"`
+
+	MatchRules types.Set `tfsdk:"match_rules"`
 }
 
 type trustProviderGcpIdentityModel struct {
-	EMail types.String `tfsdk:"email"`
+	EMail  types.String   `tfsdk:"email"`
+	EMails []types.String `tfsdk:"emails"`
+
+	MatchRules types.Set `tfsdk:"match_rules"`
 }
 
 type trustProviderGitHubActionModel struct {
-	Actor      types.String `tfsdk:"actor"`
-	Repository types.String `tfsdk:"repository"`
-	Workflow   types.String `tfsdk:"workflow"`
+	Actor            types.String   `tfsdk:"actor"`
+	Actors           []types.String `tfsdk:"actors"`
+	Repository       types.String   `tfsdk:"repository"`
+	Repositories     []types.String `tfsdk:"repositories"`
+	Workflow         types.String   `tfsdk:"workflow"`
+	Workflows        []types.String `tfsdk:"workflows"`
+	Ref              types.String   `tfsdk:"ref"`
+	Refs             []types.String `tfsdk:"refs"`
+	Environment      types.String   `tfsdk:"environment"`
+	Environments     []types.String `tfsdk:"environments"`
+	RepositoryOwner  types.String   `tfsdk:"repository_owner"`
+	RepositoryOwners []types.String `tfsdk:"repository_owners"`
+	JobWorkflowRef   types.String   `tfsdk:"job_workflow_ref"`
+	JobWorkflowRefs  []types.String `tfsdk:"job_workflow_refs"`
+	Issuer           types.String   `tfsdk:"issuer"`
+
+	MatchRules types.Set `tfsdk:"match_rules"`
+}
+
+type trustProviderGitLabJobModel struct {
+	OIDCEndpoint   types.String   `tfsdk:"oidc_endpoint"`
+	NamespacePath  types.String   `tfsdk:"namespace_path"`
+	NamespacePaths []types.String `tfsdk:"namespace_paths"`
+	ProjectPath    types.String   `tfsdk:"project_path"`
+	ProjectPaths   []types.String `tfsdk:"project_paths"`
+	RefPath        types.String   `tfsdk:"ref_path"`
+	RefPaths       []types.String `tfsdk:"ref_paths"`
+	UserLogin      types.String   `tfsdk:"user_login"`
+	UserLogins     []types.String `tfsdk:"user_logins"`
+
+	MatchRules types.Set `tfsdk:"match_rules"`
 }
 
 type trustProviderTerraformModel struct {
-	OrganizationID types.String `tfsdk:"organization_id"`
-	ProjectID      types.String `tfsdk:"project_id"`
-	WorkspaceID    types.String `tfsdk:"workspace_id"`
+	OrganizationID    types.String   `tfsdk:"organization_id"`
+	OrganizationIDs   []types.String `tfsdk:"organization_ids"`
+	ProjectID         types.String   `tfsdk:"project_id"`
+	ProjectIDs        []types.String `tfsdk:"project_ids"`
+	WorkspaceID       types.String   `tfsdk:"workspace_id"`
+	WorkspaceIDs      []types.String `tfsdk:"workspace_ids"`
+	OrganizationName  types.String   `tfsdk:"organization_name"`
+	OrganizationNames []types.String `tfsdk:"organization_names"`
+	ProjectName       types.String   `tfsdk:"project_name"`
+	ProjectNames      []types.String `tfsdk:"project_names"`
+	WorkspaceName     types.String   `tfsdk:"workspace_name"`
+	WorkspaceNames    []types.String `tfsdk:"workspace_names"`
+	RunPhase          types.String   `tfsdk:"run_phase"`
+	RunPhases         []types.String `tfsdk:"run_phases"`
+
+	MatchRules types.Set `tfsdk:"match_rules"`
 }
+
+// trustProviderMatchRuleModel maps a match rule which is not covered by the typed attributes of a trust provider.
+type trustProviderMatchRuleModel struct {
+	Attribute types.String `tfsdk:"attribute"`
+	Value     types.String `tfsdk:"value"`
+}
+
+// trustProviderMatchRuleType is the element type of the match_rules sets.
+var trustProviderMatchRuleType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"attribute": types.StringType,
+	"value":     types.StringType,
+}}
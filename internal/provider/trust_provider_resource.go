@@ -4,14 +4,21 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"sort"
+	"strings"
 
 	"aembit.io/aembit"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -31,7 +38,9 @@ func NewTrustProviderResource() resource.Resource {
 
 // trustProviderResource is the resource implementation.
 type trustProviderResource struct {
-	client *aembit.CloudClient
+	client *CloudClient
+	cache  *readCache
+	events *eventRecorder
 }
 
 // Metadata returns the resource type name.
@@ -45,18 +54,20 @@ func (r *trustProviderResource) Configure(_ context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*aembit.CloudClient)
+	data, ok := req.ProviderData.(*aembitProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *aembit.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.cache = data.cache
+	r.events = data.events
 }
 
 // Schema defines the schema for the resource.
@@ -91,21 +102,62 @@ func (r *trustProviderResource) Schema(_ context.Context, _ resource.SchemaReque
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"client_id_type": schema.StringAttribute{
+				Description: "Identity type of the Aembit Client IDs attested by the Trust Provider (`gcp_idtoken`, `github_idtoken` or `terraform_idtoken`), if it can be used for the provider `client_id`.",
+				Computed:    true,
+			},
 			"azure_metadata": schema.SingleNestedAttribute{
 				Description: "Azure Metadata type Trust Provider configuration.",
 				Optional:    true,
 				Attributes: map[string]schema.Attribute{
+					"match_rules": trustProviderMatchRulesAttribute(),
 					"sku": schema.StringAttribute{
 						Description: "Specific SKU for the Virtual Machine image.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("skus")),
+						},
+					},
+					"skus": schema.SetAttribute{
+						Description: "Set of accepted values for `sku`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("sku")),
+						},
 					},
 					"vm_id": schema.StringAttribute{
 						Description: "Unique identifier for the Virtual Machine.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("vm_ids")),
+						},
+					},
+					"vm_ids": schema.SetAttribute{
+						Description: "Set of accepted values for `vm_id`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("vm_id")),
+						},
 					},
 					"subscription_id": schema.StringAttribute{
 						Description: "Azure subscription for the Virtual Machine.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("subscription_ids")),
+						},
+					},
+					"subscription_ids": schema.SetAttribute{
+						Description: "Set of accepted values for `subscription_id`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("subscription_id")),
+						},
 					},
 				},
 			},
@@ -113,21 +165,70 @@ func (r *trustProviderResource) Schema(_ context.Context, _ resource.SchemaReque
 				Description: "AWS ECS Role type Trust Provider configuration.",
 				Optional:    true,
 				Attributes: map[string]schema.Attribute{
+					"match_rules": trustProviderMatchRulesAttribute(),
 					"account_id": schema.StringAttribute{
 						Description: "The ID of the AWS account that is hosting the ECS Task.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("account_ids")),
+						},
+					},
+					"account_ids": schema.SetAttribute{
+						Description: "Set of accepted values for `account_id`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("account_id")),
+						},
 					},
 					"assumed_role": schema.StringAttribute{
 						Description: "The Name of the AWS IAM Role which is running the ECS Task.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("assumed_roles")),
+						},
+					},
+					"assumed_roles": schema.SetAttribute{
+						Description: "Set of accepted values for `assumed_role`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("assumed_role")),
+						},
 					},
 					"role_arn": schema.StringAttribute{
 						Description: "The ARN of the AWS IAM Role which is running the ECS Task.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("role_arns")),
+						},
+					},
+					"role_arns": schema.SetAttribute{
+						Description: "Set of accepted values for `role_arn`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("role_arn")),
+						},
 					},
 					"username": schema.StringAttribute{
 						Description: "The UsernID of the AWS IAM Account which is running the ECS Task (not commonly used).",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("usernames")),
+						},
+					},
+					"usernames": schema.SetAttribute{
+						Description: "Set of accepted values for `username`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("username")),
+						},
 					},
 				},
 			},
@@ -135,21 +236,71 @@ func (r *trustProviderResource) Schema(_ context.Context, _ resource.SchemaReque
 				Description: "AWS Metadata type Trust Provider configuration.",
 				Optional:    true,
 				Attributes: map[string]schema.Attribute{
+					"match_rules": trustProviderMatchRulesAttribute(),
 					"certificate": schema.StringAttribute{
+						CustomType:  pemType{},
 						Description: "PEM Certificate to be used for Signature verification.",
 						Optional:    true,
+						Validators: []validator.String{
+							pemCertificateValidator{},
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("certificate_region")),
+						},
+					},
+					"certificate_region": schema.StringAttribute{
+						Description: "AWS region whose instance identity certificate, embedded in the provider, is used for Signature verification instead of `certificate`, e.g. `us-east-1`, `us-gov-west-1` or `cn-north-1`.",
+						Optional:    true,
+						Validators: []validator.String{
+							awsIMDSCertificateRegionValidator{},
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("certificate")),
+						},
 					},
 					"account_id": schema.StringAttribute{
 						Description: "The ID of the AWS account that launched the instance.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("account_ids")),
+						},
+					},
+					"account_ids": schema.SetAttribute{
+						Description: "Set of accepted values for `account_id`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("account_id")),
+						},
 					},
 					"architecture": schema.StringAttribute{
 						Description: "The architecture of the AMI used to launch the instance (i386 | x86_64 | arm64).",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("architectures")),
+						},
+					},
+					"architectures": schema.SetAttribute{
+						Description: "Set of accepted values for `architecture`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("architecture")),
+						},
 					},
 					"availability_zone": schema.StringAttribute{
 						Description: "The Availability Zone in which the instance is running.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("availability_zones")),
+						},
+					},
+					"availability_zones": schema.SetAttribute{
+						Description: "Set of accepted values for `availability_zone`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("availability_zone")),
+						},
 					},
 					"billing_products": schema.StringAttribute{
 						Description: "The billing products of the instance.",
@@ -158,18 +309,66 @@ func (r *trustProviderResource) Schema(_ context.Context, _ resource.SchemaReque
 					"image_id": schema.StringAttribute{
 						Description: "The ID of the AMI used to launch the instance.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("image_ids")),
+						},
+					},
+					"image_ids": schema.SetAttribute{
+						Description: "Set of accepted values for `image_id`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("image_id")),
+						},
 					},
 					"instance_id": schema.StringAttribute{
 						Description: "The ID of the instance.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("instance_ids")),
+						},
+					},
+					"instance_ids": schema.SetAttribute{
+						Description: "Set of accepted values for `instance_id`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("instance_id")),
+						},
 					},
 					"instance_type": schema.StringAttribute{
 						Description: "The instance type of the instance.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("instance_types")),
+						},
+					},
+					"instance_types": schema.SetAttribute{
+						Description: "Set of accepted values for `instance_type`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("instance_type")),
+						},
 					},
 					"kernel_id": schema.StringAttribute{
 						Description: "The ID of the kernel associated with the instance, if applicable.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("kernel_ids")),
+						},
+					},
+					"kernel_ids": schema.SetAttribute{
+						Description: "Set of accepted values for `kernel_id`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("kernel_id")),
+						},
 					},
 					"marketplace_product_codes": schema.StringAttribute{
 						Description: "The AWS Marketplace product code of the AMI used to launch the instance.",
@@ -178,22 +377,153 @@ func (r *trustProviderResource) Schema(_ context.Context, _ resource.SchemaReque
 					"pending_time": schema.StringAttribute{
 						Description: "The date and time that the instance was launched.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("pending_times")),
+						},
+					},
+					"pending_times": schema.SetAttribute{
+						Description: "Set of accepted values for `pending_time`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("pending_time")),
+						},
 					},
 					"private_ip": schema.StringAttribute{
 						Description: "The private IPv4 address of the instance.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("private_ips")),
+						},
+					},
+					"private_ips": schema.SetAttribute{
+						Description: "Set of accepted values for `private_ip`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("private_ip")),
+						},
 					},
 					"ramdisk_id": schema.StringAttribute{
 						Description: "The ID of the RAM disk associated with the instance, if applicable.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ramdisk_ids")),
+						},
+					},
+					"ramdisk_ids": schema.SetAttribute{
+						Description: "Set of accepted values for `ramdisk_id`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ramdisk_id")),
+						},
 					},
 					"region": schema.StringAttribute{
 						Description: "The Region in which the instance is running.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("regions")),
+						},
+					},
+					"regions": schema.SetAttribute{
+						Description: "Set of accepted values for `region`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("region")),
+						},
 					},
 					"version": schema.StringAttribute{
 						Description: "The version of the instance identity document format.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("versions")),
+						},
+					},
+					"versions": schema.SetAttribute{
+						Description: "Set of accepted values for `version`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("version")),
+						},
+					},
+				},
+			},
+			"aws_role": schema.SingleNestedAttribute{
+				Description: "AWS Role type Trust Provider configuration, based on the AWS STS GetCallerIdentity response.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"match_rules": trustProviderMatchRulesAttribute(),
+					"account_id": schema.StringAttribute{
+						Description: "The ID of the AWS account of the calling AWS IAM Role.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("account_ids")),
+						},
+					},
+					"account_ids": schema.SetAttribute{
+						Description: "Set of accepted values for `account_id`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("account_id")),
+						},
+					},
+					"assumed_role": schema.StringAttribute{
+						Description: "The Name of the AWS IAM Role which is assumed by the caller.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("assumed_roles")),
+						},
+					},
+					"assumed_roles": schema.SetAttribute{
+						Description: "Set of accepted values for `assumed_role`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("assumed_role")),
+						},
+					},
+					"role_arn": schema.StringAttribute{
+						Description: "The ARN of the AWS IAM Role which is assumed by the caller.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("role_arns")),
+						},
+					},
+					"role_arns": schema.SetAttribute{
+						Description: "Set of accepted values for `role_arn`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("role_arn")),
+						},
+					},
+					"username": schema.StringAttribute{
+						Description: "The UserID of the AWS IAM Account of the caller (not commonly used).",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("usernames")),
+						},
+					},
+					"usernames": schema.SetAttribute{
+						Description: "Set of accepted values for `username`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("username")),
+						},
 					},
 				},
 			},
@@ -201,9 +531,22 @@ func (r *trustProviderResource) Schema(_ context.Context, _ resource.SchemaReque
 				Description: "GCP Identity type Trust Provider configuration.",
 				Optional:    true,
 				Attributes: map[string]schema.Attribute{
+					"match_rules": trustProviderMatchRulesAttribute(),
 					"email": schema.StringAttribute{
 						Description: "The Email of the GCP Service Account used by the associated GCP resource.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("emails")),
+						},
+					},
+					"emails": schema.SetAttribute{
+						Description: "Set of accepted values for `email`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("email")),
+						},
 					},
 				},
 			},
@@ -211,77 +554,445 @@ func (r *trustProviderResource) Schema(_ context.Context, _ resource.SchemaReque
 				Description: "GitHub Action type Trust Provider configuration.",
 				Optional:    true,
 				Attributes: map[string]schema.Attribute{
+					"match_rules": trustProviderMatchRulesAttribute(),
 					"actor": schema.StringAttribute{
 						Description: "The GitHub Actor which initiated the GitHub Action.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("actors")),
+						},
+					},
+					"actors": schema.SetAttribute{
+						Description: "Set of accepted values for `actor`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("actor")),
+						},
+					},
+					"repository": schema.StringAttribute{
+						Description: "The GitHub Repository associated with the GitHub Action ID Token.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("repositories")),
+						},
+					},
+					"repositories": schema.SetAttribute{
+						Description: "Set of accepted values for `repository`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("repository")),
+						},
+					},
+					"workflow": schema.StringAttribute{
+						Description: "The GitHub Workflow execution associated with the GitHub Action ID Token.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("workflows")),
+						},
+					},
+					"workflows": schema.SetAttribute{
+						Description: "Set of accepted values for `workflow`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("workflow")),
+						},
+					},
+					"ref": schema.StringAttribute{
+						Description: "The Git Ref (`ref` claim) of the GitHub Action ID Token, e.g. `refs/heads/main`.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("refs")),
+						},
+					},
+					"refs": schema.SetAttribute{
+						Description: "Set of accepted values for `ref`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ref")),
+						},
+					},
+					"environment": schema.StringAttribute{
+						Description: "The GitHub Environment (`environment` claim) of the job associated with the GitHub Action ID Token.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("environments")),
+						},
+					},
+					"environments": schema.SetAttribute{
+						Description: "Set of accepted values for `environment`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("environment")),
+						},
+					},
+					"repository_owner": schema.StringAttribute{
+						Description: "The Owner (`repository_owner` claim) of the repository associated with the GitHub Action ID Token.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("repository_owners")),
+						},
+					},
+					"repository_owners": schema.SetAttribute{
+						Description: "Set of accepted values for `repository_owner`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("repository_owner")),
+						},
+					},
+					"job_workflow_ref": schema.StringAttribute{
+						Description: "The Reusable Workflow Ref (`job_workflow_ref` claim) of the job associated with the GitHub Action ID Token, e.g. `octo-org/octo-automation/.github/workflows/deploy.yml@refs/heads/main`.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("job_workflow_refs")),
+						},
+					},
+					"job_workflow_refs": schema.SetAttribute{
+						Description: "Set of accepted values for `job_workflow_ref`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("job_workflow_ref")),
+						},
+					},
+					"issuer": schema.StringAttribute{
+						Description: "The Issuer of the GitHub Action ID Tokens. Set to `https://HOSTNAME/_services/token` for GitHub Enterprise Server. " +
+							"Defaults to the github.com issuer `https://token.actions.githubusercontent.com`.",
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString(gitHubActionIssuer),
+					},
+				},
+			},
+			"gitlab_job": schema.SingleNestedAttribute{
+				Description: "GitLab Job type Trust Provider configuration.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"match_rules": trustProviderMatchRulesAttribute(),
+					"oidc_endpoint": schema.StringAttribute{
+						Description: "The GitLab instance which issues the GitLab Job ID Tokens. Defaults to `https://gitlab.com`.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("https://gitlab.com"),
+					},
+					"namespace_path": schema.StringAttribute{
+						Description: "The Namespace Path (`namespace_path` claim) of the GitLab Job ID Token, e.g. `my-group`.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("namespace_paths")),
+						},
+					},
+					"namespace_paths": schema.SetAttribute{
+						Description: "Set of accepted values for `namespace_path`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("namespace_path")),
+						},
+					},
+					"project_path": schema.StringAttribute{
+						Description: "The Project Path (`project_path` claim) of the GitLab Job ID Token, e.g. `my-group/my-project`.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("project_paths")),
+						},
+					},
+					"project_paths": schema.SetAttribute{
+						Description: "Set of accepted values for `project_path`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("project_path")),
+						},
+					},
+					"ref_path": schema.StringAttribute{
+						Description: "The Ref Path (`ref_path` claim) of the GitLab Job ID Token, e.g. `refs/heads/main`.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ref_paths")),
+						},
+					},
+					"ref_paths": schema.SetAttribute{
+						Description: "Set of accepted values for `ref_path`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ref_path")),
+						},
+					},
+					"user_login": schema.StringAttribute{
+						Description: "The User Login (`user_login` claim) of the GitLab user which started the GitLab Job.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("user_logins")),
+						},
+					},
+					"user_logins": schema.SetAttribute{
+						Description: "Set of accepted values for `user_login`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("user_login")),
+						},
+					},
+				},
+			},
+			"kerberos": schema.SingleNestedAttribute{
+				Description: "Kerberos type Trust Provider configuration.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"match_rules": trustProviderMatchRulesAttribute(),
+					"agent_controller_ids": schema.SetAttribute{
+						Description: "Unique identifier for the Aembit Agent Controller to use for Signature verification.",
+						Required:    true,
+						ElementType: types.StringType,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+					},
+					"principal": schema.StringAttribute{
+						Description: "The Kerberos Principal of the authenticated Agent Proxy.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("principals")),
+						},
+					},
+					"principals": schema.SetAttribute{
+						Description: "Set of accepted values for `principal`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("principal")),
+						},
+					},
+					"realm": schema.StringAttribute{
+						Description: "The Kerberos Realm of the authenticated Agent Proxy.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("realms")),
+						},
+					},
+					"realms": schema.SetAttribute{
+						Description: "Set of accepted values for `realm`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("realm")),
+						},
+					},
+					"source_ip": schema.StringAttribute{
+						Description: "The Source IP Address of the authenticated Agent Proxy.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("source_ips")),
+						},
+					},
+					"source_ips": schema.SetAttribute{
+						Description: "Set of accepted values for `source_ip`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("source_ip")),
+						},
+					},
+				},
+			},
+			"kubernetes_service_account": schema.SingleNestedAttribute{
+				Description: "Kubernetes Service Account type Trust Provider configuration.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"match_rules": trustProviderMatchRulesAttribute(),
+					"issuer": schema.StringAttribute{
+						Description: "The Issuer (`iss` claim) of the Kubernetes Service Account Token.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("issuers")),
+						},
+					},
+					"issuers": schema.SetAttribute{
+						Description: "Set of accepted values for `issuer`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("issuer")),
+						},
+					},
+					"namespace": schema.StringAttribute{
+						Description: "The Namespace of the Kubernetes Service Account Token.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("namespaces")),
+						},
+					},
+					"namespaces": schema.SetAttribute{
+						Description: "Set of accepted values for `namespace`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("namespace")),
+						},
+					},
+					"pod_name": schema.StringAttribute{
+						Description: "The Pod Name of the Kubernetes Service Account Token.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("pod_names")),
+						},
+					},
+					"pod_names": schema.SetAttribute{
+						Description: "Set of accepted values for `pod_name`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("pod_name")),
+						},
+					},
+					"service_account_name": schema.StringAttribute{
+						Description: "The Service Account Name of the Kubernetes Service Account Token.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("service_account_names")),
+						},
 					},
-					"repository": schema.StringAttribute{
-						Description: "The GitHub Repository associated with the GitHub Action ID Token.",
+					"service_account_names": schema.SetAttribute{
+						Description: "Set of accepted values for `service_account_name`, matching any one of them.",
+						ElementType: types.StringType,
 						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("service_account_name")),
+						},
 					},
-					"workflow": schema.StringAttribute{
-						Description: "The GitHub Workflow execution associated with the GitHub Action ID Token.",
+					"subject": schema.StringAttribute{
+						Description: "The Subject (`sub` claim) of the Kubernetes Service Account Token.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("subjects")),
+						},
 					},
-				},
-			},
-			"kerberos": schema.SingleNestedAttribute{
-				Description: "Kerberos type Trust Provider configuration.",
-				Optional:    true,
-				Attributes: map[string]schema.Attribute{
-					"agent_controller_ids": schema.SetAttribute{
-						Description: "Unique identifier for the Aembit Agent Controller to use for Signature verification.",
-						Required:    true,
+					"subjects": schema.SetAttribute{
+						Description: "Set of accepted values for `subject`, matching any one of them.",
 						ElementType: types.StringType,
+						Optional:    true,
 						Validators: []validator.Set{
 							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("subject")),
 						},
 					},
-					"principal": schema.StringAttribute{
-						Description: "The Kerberos Principal of the authenticated Agent Proxy.",
+					"oidc_endpoint": schema.StringAttribute{
+						Description: "The OIDC Endpoint from which Public Keys can be retrieved for verifying the signature of the Kubernetes Service Account Token.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("public_key"),
+								path.MatchRelative().AtParent().AtName("jwks"),
+							),
+						},
 					},
-					"realm": schema.StringAttribute{
-						Description: "The Kerberos Realm of the authenticated Agent Proxy.",
+					"public_key": schema.StringAttribute{
+						CustomType:  pemType{},
+						Description: "The Public Key that can be used to verify the signature of the Kubernetes Service Account Token.",
 						Optional:    true,
+						Validators: []validator.String{
+							pemValidator{},
+						},
 					},
-					"source_ip": schema.StringAttribute{
-						Description: "The Source IP Address of the authenticated Agent Proxy.",
-						Optional:    true,
+					"jwks": schema.StringAttribute{
+						Description: "The JSON Web Key Set of the Kubernetes cluster, as served at `/openid/v1/jwks`, for clusters without a public OIDC issuer. " +
+							"Its RSA and EC signing keys are used to verify the signature of the Kubernetes Service Account Token.",
+						Optional: true,
+						Validators: []validator.String{
+							jwksValidator{},
+						},
 					},
 				},
 			},
-			"kubernetes_service_account": schema.SingleNestedAttribute{
-				Description: "Kubernetes Service Account type Trust Provider configuration.",
+			"oidc_id_token": schema.SingleNestedAttribute{
+				Description: "OIDC ID Token type Trust Provider configuration, for ID Tokens of any OIDC compliant identity provider.",
 				Optional:    true,
 				Attributes: map[string]schema.Attribute{
+					"match_rules": trustProviderMatchRulesAttribute(),
 					"issuer": schema.StringAttribute{
-						Description: "The Issuer (`iss` claim) of the Kubernetes Service Account Token.",
+						Description: "The Issuer (`iss` claim) of the OIDC ID Token.",
+						Required:    true,
+					},
+					"jwks_url": schema.StringAttribute{
+						Description: "The URL of the JSON Web Key Set which is used to verify the signature of the OIDC ID Token.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("public_key")),
+						},
 					},
-					"namespace": schema.StringAttribute{
-						Description: "The Namespace of the Kubernetes Service Account Token.",
+					"public_key": schema.StringAttribute{
+						CustomType:  pemType{},
+						Description: "The Public Key which is used to verify the signature of the OIDC ID Token.",
 						Optional:    true,
+						Validators: []validator.String{
+							pemValidator{},
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("jwks_url")),
+						},
 					},
-					"pod_name": schema.StringAttribute{
-						Description: "The Pod Name of the Kubernetes Service Account Token.",
+					"audience": schema.StringAttribute{
+						Description: "The Audience (`aud` claim) of the OIDC ID Token.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("audiences")),
+						},
 					},
-					"service_account_name": schema.StringAttribute{
-						Description: "The Service Account Name of the Kubernetes Service Account Token.",
+					"audiences": schema.SetAttribute{
+						Description: "Set of accepted values for `audience`, matching any one of them.",
+						ElementType: types.StringType,
 						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("audience")),
+						},
 					},
 					"subject": schema.StringAttribute{
-						Description: "The Subject (`sub` claim) of the Kubernetes Service Account Token.",
+						Description: "The Subject (`sub` claim) of the OIDC ID Token.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("subjects")),
+						},
 					},
-					"oidc_endpoint": schema.StringAttribute{
-						Description: "The OIDC Endpoint from which Public Keys can be retrieved for verifying the signature of the Kubernetes Service Account Token.",
+					"subjects": schema.SetAttribute{
+						Description: "Set of accepted values for `subject`, matching any one of them.",
+						ElementType: types.StringType,
 						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("subject")),
+						},
 					},
-					"public_key": schema.StringAttribute{
-						Description: "The Public Key that can be used to verify the signature of the Kubernetes Service Account Token.",
+					"claims": schema.MapAttribute{
+						Description: "Additional claims of the OIDC ID Token, mapped to the value they must have.",
+						ElementType: types.StringType,
 						Optional:    true,
+						Validators: []validator.Map{
+							mapvalidator.SizeAtLeast(1),
+						},
 					},
 				},
 			},
@@ -289,19 +1000,151 @@ func (r *trustProviderResource) Schema(_ context.Context, _ resource.SchemaReque
 				Description: "Terraform Workspace type Trust Provider configuration.",
 				Optional:    true,
 				Attributes: map[string]schema.Attribute{
+					"match_rules": trustProviderMatchRulesAttribute(),
 					"organization_id": schema.StringAttribute{
 						Description: "The Organization ID of the calling Terraform Workspace.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("organization_ids")),
+						},
+					},
+					"organization_ids": schema.SetAttribute{
+						Description: "Set of accepted values for `organization_id`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("organization_id")),
+						},
 					},
 					"project_id": schema.StringAttribute{
 						Description: "The Project ID of the calling Terraform Workspace.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("project_ids")),
+						},
+					},
+					"project_ids": schema.SetAttribute{
+						Description: "Set of accepted values for `project_id`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("project_id")),
+						},
 					},
 					"workspace_id": schema.StringAttribute{
 						Description: "The Workspace ID of the calling Terraform Workspace.",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("workspace_ids")),
+						},
+					},
+					"workspace_ids": schema.SetAttribute{
+						Description: "Set of accepted values for `workspace_id`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("workspace_id")),
+						},
+					},
+					"organization_name": schema.StringAttribute{
+						Description: "The Organization Name of the calling Terraform Workspace.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("organization_names")),
+						},
+					},
+					"organization_names": schema.SetAttribute{
+						Description: "Set of accepted values for `organization_name`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("organization_name")),
+						},
+					},
+					"project_name": schema.StringAttribute{
+						Description: "The Project Name of the calling Terraform Workspace.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("project_names")),
+						},
+					},
+					"project_names": schema.SetAttribute{
+						Description: "Set of accepted values for `project_name`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("project_name")),
+						},
+					},
+					"workspace_name": schema.StringAttribute{
+						Description: "The Workspace Name of the calling Terraform Workspace.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("workspace_names")),
+						},
+					},
+					"workspace_names": schema.SetAttribute{
+						Description: "Set of accepted values for `workspace_name`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("workspace_name")),
+						},
+					},
+					"run_phase": schema.StringAttribute{
+						Description: "The Run Phase (`terraform_run_phase` claim) of the calling Terraform run, either `plan` or `apply`.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("run_phases")),
+							stringvalidator.OneOf("plan", "apply"),
+						},
+					},
+					"run_phases": schema.SetAttribute{
+						Description: "Set of accepted values for `run_phase`, matching any one of them.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("run_phase")),
+							setvalidator.ValueStringsAre(stringvalidator.OneOf("plan", "apply")),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// trustProviderMatchRulesAttribute defines the match rules which are not covered by the typed attributes of a trust provider.
+func trustProviderMatchRulesAttribute() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Description: "Additional match rules, for match attributes which are not available as attributes of the trust provider type. " +
+			"Match rules returned by Aembit for unknown match attributes are kept here. " +
+			"If `match_rules` is not configured, the current match rules are kept; set it to an empty set to remove them.",
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"attribute": schema.StringAttribute{
+					Description: "Aembit name of the match attribute, e.g. `GithubRunnerEnvironment`.",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
 					},
 				},
+				"value": schema.StringAttribute{
+					Description: "Value which the match attribute must have.",
+					Required:    true,
+				},
 			},
 		},
 	}
@@ -313,11 +1156,14 @@ func (r *trustProviderResource) ConfigValidators(_ context.Context) []resource.C
 		resourcevalidator.ExactlyOneOf(
 			path.MatchRoot("aws_ecs_role"),
 			path.MatchRoot("aws_metadata"),
+			path.MatchRoot("aws_role"),
 			path.MatchRoot("azure_metadata"),
 			path.MatchRoot("gcp_identity"),
 			path.MatchRoot("github_action"),
+			path.MatchRoot("gitlab_job"),
 			path.MatchRoot("kerberos"),
 			path.MatchRoot("kubernetes_service_account"),
+			path.MatchRoot("oidc_id_token"),
 			path.MatchRoot("terraform_workspace"),
 		),
 	}
@@ -334,7 +1180,16 @@ func (r *trustProviderResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	// Generate API request body from plan
-	var trust aembit.TrustProviderDTO = convertTrustProviderModelToDTO(ctx, plan, nil)
+	trust, err := convertTrustProviderModelToDTO(ctx, plan, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Trust Provider",
+			"Could not convert the Trust Provider configuration, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	defer r.cache.invalidate("trust_provider")
 
 	// Create new Trust Provider
 	trustProvider, err := r.client.CreateTrustProvider(trust, nil)
@@ -347,7 +1202,7 @@ func (r *trustProviderResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	plan = convertTrustProviderDTOToModel(ctx, *trustProvider)
+	plan = convertTrustProviderDTOToModel(ctx, *trustProvider, plan)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -355,6 +1210,8 @@ func (r *trustProviderResource) Create(ctx context.Context, req resource.CreateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.events.record(ctx, "trust_provider", plan.ID.ValueString(), eventActionCreate)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -368,7 +1225,7 @@ func (r *trustProviderResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// Get refreshed trust value from Aembit
-	trustProvider, err := r.client.GetTrustProvider(state.ID.ValueString(), nil)
+	trustProvider, err := cachedGet(r.cache, "trust_provider", state.ID.ValueString(), r.client.GetTrustProviders, r.client.GetTrustProvider, func(e aembit.TrustProviderDTO) aembit.EntityDTO { return e.EntityDTO })
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Aembit Trust Provider",
@@ -377,7 +1234,7 @@ func (r *trustProviderResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	state = convertTrustProviderDTOToModel(ctx, trustProvider)
+	state = convertTrustProviderDTOToModel(ctx, trustProvider, state)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -409,7 +1266,16 @@ func (r *trustProviderResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	// Generate API request body from plan
-	var trust aembit.TrustProviderDTO = convertTrustProviderModelToDTO(ctx, plan, &externalID)
+	trust, err := convertTrustProviderModelToDTO(ctx, plan, &externalID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Trust Provider",
+			"Could not convert the Trust Provider configuration, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	defer r.cache.invalidate("trust_provider")
 
 	// Update Trust Provider
 	trustProvider, err := r.client.UpdateTrustProvider(trust, nil)
@@ -422,7 +1288,7 @@ func (r *trustProviderResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	state = convertTrustProviderDTOToModel(ctx, *trustProvider)
+	state = convertTrustProviderDTOToModel(ctx, *trustProvider, plan)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, state)
@@ -430,6 +1296,8 @@ func (r *trustProviderResource) Update(ctx context.Context, req resource.UpdateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.events.record(ctx, "trust_provider", state.ID.ValueString(), eventActionUpdate)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -442,6 +1310,8 @@ func (r *trustProviderResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	defer r.cache.invalidate("trust_provider")
+
 	// Check if Trust Provider is Active - if it is, disable it first
 	if state.IsActive == types.BoolValue(true) {
 		_, err := r.client.DisableTrustProvider(state.ID.ValueString(), nil)
@@ -463,6 +1333,8 @@ func (r *trustProviderResource) Delete(ctx context.Context, req resource.DeleteR
 		)
 		return
 	}
+
+	r.events.record(ctx, "trust_provider", state.ID.ValueString(), eventActionDelete)
 }
 
 // Imports an existing resource by passing externalId.
@@ -472,7 +1344,7 @@ func (r *trustProviderResource) ImportState(ctx context.Context, req resource.Im
 }
 
 // Model to DTO conversion methods.
-func convertTrustProviderModelToDTO(ctx context.Context, model trustProviderResourceModel, externalID *string) aembit.TrustProviderDTO {
+func convertTrustProviderModelToDTO(ctx context.Context, model trustProviderResourceModel, externalID *string) (aembit.TrustProviderDTO, error) {
 	var trust aembit.TrustProviderDTO
 	trust.EntityDTO = aembit.EntityDTO{
 		Name:        model.Name.ValueString(),
@@ -501,6 +1373,9 @@ func convertTrustProviderModelToDTO(ctx context.Context, model trustProviderReso
 	if model.AwsEcsRole != nil {
 		convertAwsEcsRoleModelToDTO(model, &trust)
 	}
+	if model.AwsRole != nil {
+		convertAwsRoleModelToDTO(model, &trust)
+	}
 	if model.AzureMetadata != nil {
 		convertAzureMetadataModelToDTO(model, &trust)
 	}
@@ -510,17 +1385,50 @@ func convertTrustProviderModelToDTO(ctx context.Context, model trustProviderReso
 	if model.GitHubAction != nil {
 		convertGitHubActionModelToDTO(model, &trust)
 	}
+	if model.GitLabJob != nil {
+		convertGitLabJobModelToDTO(model, &trust)
+	}
 	if model.Kerberos != nil {
 		convertKerberosModelToDTO(model, &trust)
 	}
 	if model.KubernetesService != nil {
-		convertKubernetesModelToDTO(model, &trust)
+		if err := convertKubernetesModelToDTO(model, &trust); err != nil {
+			return trust, err
+		}
+	}
+	if model.OidcIDToken != nil {
+		convertOidcIDTokenModelToDTO(ctx, model, &trust)
 	}
 	if model.TerraformWorkspace != nil {
 		convertTerraformModelToDTO(model, &trust)
 	}
 
-	return trust
+	return trust, nil
+}
+
+// appendMatchRulesIfExists appends a match rule for the single value, and one for each of the set of values.
+func appendMatchRulesIfExists(matchRules []aembit.TrustProviderMatchRuleDTO, value basetypes.StringValue, values []types.String, attrName string) []aembit.TrustProviderMatchRuleDTO {
+	matchRules = appendMatchRuleIfExists(matchRules, value, attrName)
+	for _, setValue := range values {
+		matchRules = appendMatchRuleIfExists(matchRules, setValue, attrName)
+	}
+	return matchRules
+}
+
+// gitHubActionIssuer is the issuer of the GitHub Action ID Tokens of github.com.
+const gitHubActionIssuer = "https://token.actions.githubusercontent.com"
+
+// oidcClaimMatchRulePrefix prefixes the claim name in the match rule attribute of the OIDC ID Token claims.
+const oidcClaimMatchRulePrefix = "OidcClaim:"
+
+// appendMatchRuleModels appends the additional match rules configured with match_rules.
+func appendMatchRuleModels(matchRules []aembit.TrustProviderMatchRuleDTO, rules types.Set) []aembit.TrustProviderMatchRuleDTO {
+	for _, rule := range matchRuleModels(rules) {
+		matchRules = append(matchRules, aembit.TrustProviderMatchRuleDTO{
+			Attribute: rule.Attribute.ValueString(), Value: rule.Value.ValueString(),
+		})
+	}
+	return matchRules
 }
 
 func appendMatchRuleIfExists(matchRules []aembit.TrustProviderMatchRuleDTO, value basetypes.StringValue, attrName string) []aembit.TrustProviderMatchRuleDTO {
@@ -536,57 +1444,94 @@ func convertAzureMetadataModelToDTO(model trustProviderResourceModel, dto *aembi
 	dto.Provider = "AzureMetadataService"
 
 	dto.MatchRules = make([]aembit.TrustProviderMatchRuleDTO, 0)
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AzureMetadata.Sku, "AzureSku")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AzureMetadata.VMID, "AzureVmId")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AzureMetadata.SubscriptionID, "AzureSubscriptionId")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AzureMetadata.Sku, model.AzureMetadata.Skus, "AzureSku")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AzureMetadata.VMID, model.AzureMetadata.VMIDs, "AzureVmId")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AzureMetadata.SubscriptionID, model.AzureMetadata.SubscriptionIDs, "AzureSubscriptionId")
+	dto.MatchRules = appendMatchRuleModels(dto.MatchRules, model.AzureMetadata.MatchRules)
 }
 
 func convertAwsEcsRoleModelToDTO(model trustProviderResourceModel, dto *aembit.TrustProviderDTO) {
 	dto.Provider = "AWSECSRole"
 
 	dto.MatchRules = make([]aembit.TrustProviderMatchRuleDTO, 0)
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AwsEcsRole.AccountID, "AwsAccountId")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AwsEcsRole.AssumedRole, "AwsAssumedRole")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AwsEcsRole.RoleARN, "AwsRoleARN")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AwsEcsRole.Username, "AwsUsername")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsEcsRole.AccountID, model.AwsEcsRole.AccountIDs, "AwsAccountId")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsEcsRole.AssumedRole, model.AwsEcsRole.AssumedRoles, "AwsAssumedRole")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsEcsRole.RoleARN, model.AwsEcsRole.RoleARNs, "AwsRoleARN")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsEcsRole.Username, model.AwsEcsRole.Usernames, "AwsUsername")
+	dto.MatchRules = appendMatchRuleModels(dto.MatchRules, model.AwsEcsRole.MatchRules)
 }
 
 func convertAwsMetadataModelToDTO(model trustProviderResourceModel, dto *aembit.TrustProviderDTO) {
 	dto.Provider = "AWSMetadataService"
-	dto.Certificate = base64.StdEncoding.EncodeToString([]byte(model.AwsMetadata.Certificate.ValueString()))
+	certificate := model.AwsMetadata.Certificate.ValueString()
+	if region := model.AwsMetadata.CertificateRegion.ValueString(); len(region) > 0 {
+		certificate = awsIMDSCertificates[region]
+	}
+	dto.Certificate = base64.StdEncoding.EncodeToString([]byte(certificate))
 	dto.PemType = "Certificate"
 
 	dto.MatchRules = make([]aembit.TrustProviderMatchRuleDTO, 0)
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AwsMetadata.AccountID, "AwsAccountId")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AwsMetadata.Architecture, "AwsArchitecture")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AwsMetadata.AvailabilityZone, "AwsAvailabilityZone")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsMetadata.AccountID, model.AwsMetadata.AccountIDs, "AwsAccountId")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsMetadata.Architecture, model.AwsMetadata.Architectures, "AwsArchitecture")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsMetadata.AvailabilityZone, model.AwsMetadata.AvailabilityZones, "AwsAvailabilityZone")
 	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AwsMetadata.BillingProducts, "AwsBillingProducts")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AwsMetadata.ImageID, "AwsImageId")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AwsMetadata.InstanceID, "AwsInstanceId")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AwsMetadata.InstanceType, "AwsInstanceType")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AwsMetadata.KernelID, "AwsKernelId")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsMetadata.ImageID, model.AwsMetadata.ImageIDs, "AwsImageId")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsMetadata.InstanceID, model.AwsMetadata.InstanceIDs, "AwsInstanceId")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsMetadata.InstanceType, model.AwsMetadata.InstanceTypes, "AwsInstanceType")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsMetadata.KernelID, model.AwsMetadata.KernelIDs, "AwsKernelId")
 	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AwsMetadata.MarketplaceProductCodes, "AwsMarketplaceProductCodes")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AwsMetadata.PendingTime, "AwsPendingTime")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AwsMetadata.PrivateIP, "AwsPrivateIp")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AwsMetadata.RamdiskID, "AwsRamdiskId")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AwsMetadata.Region, "AwsRegion")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.AwsMetadata.Version, "AwsVersion")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsMetadata.PendingTime, model.AwsMetadata.PendingTimes, "AwsPendingTime")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsMetadata.PrivateIP, model.AwsMetadata.PrivateIPs, "AwsPrivateIp")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsMetadata.RamdiskID, model.AwsMetadata.RamdiskIDs, "AwsRamdiskId")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsMetadata.Region, model.AwsMetadata.Regions, "AwsRegion")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsMetadata.Version, model.AwsMetadata.Versions, "AwsVersion")
+	dto.MatchRules = appendMatchRuleModels(dto.MatchRules, model.AwsMetadata.MatchRules)
+}
+
+func convertAwsRoleModelToDTO(model trustProviderResourceModel, dto *aembit.TrustProviderDTO) {
+	dto.Provider = "AWSRole"
+
+	dto.MatchRules = make([]aembit.TrustProviderMatchRuleDTO, 0)
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsRole.AccountID, model.AwsRole.AccountIDs, "AwsAccountId")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsRole.AssumedRole, model.AwsRole.AssumedRoles, "AwsAssumedRole")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsRole.RoleARN, model.AwsRole.RoleARNs, "AwsRoleARN")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.AwsRole.Username, model.AwsRole.Usernames, "AwsUsername")
+	dto.MatchRules = appendMatchRuleModels(dto.MatchRules, model.AwsRole.MatchRules)
 }
 
 func convertGcpIdentityModelToDTO(model trustProviderResourceModel, dto *aembit.TrustProviderDTO) {
 	dto.Provider = "GcpIdentityToken"
 
 	dto.MatchRules = make([]aembit.TrustProviderMatchRuleDTO, 0)
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.GcpIdentity.EMail, "Email")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.GcpIdentity.EMail, model.GcpIdentity.EMails, "Email")
+	dto.MatchRules = appendMatchRuleModels(dto.MatchRules, model.GcpIdentity.MatchRules)
 }
 
 func convertGitHubActionModelToDTO(model trustProviderResourceModel, dto *aembit.TrustProviderDTO) {
 	dto.Provider = "GitHubIdentityToken"
+	dto.OidcUrl = model.GitHubAction.Issuer.ValueString()
+
+	dto.MatchRules = make([]aembit.TrustProviderMatchRuleDTO, 0)
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.GitHubAction.Actor, model.GitHubAction.Actors, "GithubActor")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.GitHubAction.Repository, model.GitHubAction.Repositories, "GithubRepository")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.GitHubAction.Workflow, model.GitHubAction.Workflows, "GithubWorkflow")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.GitHubAction.Ref, model.GitHubAction.Refs, "GithubRef")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.GitHubAction.Environment, model.GitHubAction.Environments, "GithubEnvironment")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.GitHubAction.RepositoryOwner, model.GitHubAction.RepositoryOwners, "GithubRepositoryOwner")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.GitHubAction.JobWorkflowRef, model.GitHubAction.JobWorkflowRefs, "GithubJobWorkflowRef")
+	dto.MatchRules = appendMatchRuleModels(dto.MatchRules, model.GitHubAction.MatchRules)
+}
+
+func convertGitLabJobModelToDTO(model trustProviderResourceModel, dto *aembit.TrustProviderDTO) {
+	dto.Provider = "GitLabIdentityToken"
+	dto.OidcUrl = model.GitLabJob.OIDCEndpoint.ValueString()
 
 	dto.MatchRules = make([]aembit.TrustProviderMatchRuleDTO, 0)
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.GitHubAction.Actor, "GithubActor")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.GitHubAction.Repository, "GithubRepository")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.GitHubAction.Workflow, "GithubWorkflow")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.GitLabJob.NamespacePath, model.GitLabJob.NamespacePaths, "GitLabNamespacePath")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.GitLabJob.ProjectPath, model.GitLabJob.ProjectPaths, "GitLabProjectPath")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.GitLabJob.RefPath, model.GitLabJob.RefPaths, "GitLabRefPath")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.GitLabJob.UserLogin, model.GitLabJob.UserLogins, "GitLabUserLogin")
+	dto.MatchRules = appendMatchRuleModels(dto.MatchRules, model.GitLabJob.MatchRules)
 }
 
 func convertKerberosModelToDTO(model trustProviderResourceModel, dto *aembit.TrustProviderDTO) {
@@ -597,275 +1542,466 @@ func convertKerberosModelToDTO(model trustProviderResourceModel, dto *aembit.Tru
 	}
 
 	dto.MatchRules = make([]aembit.TrustProviderMatchRuleDTO, 0)
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.Kerberos.Principal, "Principal")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.Kerberos.Realm, "Realm")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.Kerberos.SourceIP, "SourceIp")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.Kerberos.Principal, model.Kerberos.Principals, "Principal")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.Kerberos.Realm, model.Kerberos.Realms, "Realm")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.Kerberos.SourceIP, model.Kerberos.SourceIPs, "SourceIp")
+	dto.MatchRules = appendMatchRuleModels(dto.MatchRules, model.Kerberos.MatchRules)
 }
 
-func convertKubernetesModelToDTO(model trustProviderResourceModel, dto *aembit.TrustProviderDTO) {
+func convertKubernetesModelToDTO(model trustProviderResourceModel, dto *aembit.TrustProviderDTO) error {
 	dto.Provider = "KubernetesServiceAccount"
-	dto.Certificate = base64.StdEncoding.EncodeToString([]byte(model.KubernetesService.PublicKey.ValueString()))
+	publicKey := model.KubernetesService.PublicKey.ValueString()
+	if !model.KubernetesService.Jwks.IsNull() {
+		var err error
+		if publicKey, err = convertJWKSToPEM(model.KubernetesService.Jwks.ValueString()); err != nil {
+			return fmt.Errorf("invalid kubernetes_service_account jwks: %w", err)
+		}
+	}
+	dto.Certificate = base64.StdEncoding.EncodeToString([]byte(publicKey))
 	if len(dto.Certificate) > 0 {
 		dto.PemType = "PublicKey"
 	}
 	dto.OidcUrl = model.KubernetesService.OIDCEndpoint.ValueString()
 
 	dto.MatchRules = make([]aembit.TrustProviderMatchRuleDTO, 0)
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.KubernetesService.Issuer, "KubernetesIss")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.KubernetesService.Namespace, "KubernetesIoNamespace")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.KubernetesService.PodName, "KubernetesIoPodName")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.KubernetesService.ServiceAccountName, "KubernetesIoServiceAccountName")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.KubernetesService.Subject, "KubernetesSub")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.KubernetesService.Issuer, model.KubernetesService.Issuers, "KubernetesIss")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.KubernetesService.Namespace, model.KubernetesService.Namespaces, "KubernetesIoNamespace")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.KubernetesService.PodName, model.KubernetesService.PodNames, "KubernetesIoPodName")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.KubernetesService.ServiceAccountName, model.KubernetesService.ServiceAccountNames, "KubernetesIoServiceAccountName")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.KubernetesService.Subject, model.KubernetesService.Subjects, "KubernetesSub")
+	dto.MatchRules = appendMatchRuleModels(dto.MatchRules, model.KubernetesService.MatchRules)
+	return nil
+}
+
+func convertOidcIDTokenModelToDTO(ctx context.Context, model trustProviderResourceModel, dto *aembit.TrustProviderDTO) {
+	dto.Provider = "OidcIdToken"
+	dto.Certificate = base64.StdEncoding.EncodeToString([]byte(model.OidcIDToken.PublicKey.ValueString()))
+	if len(dto.Certificate) > 0 {
+		dto.PemType = "PublicKey"
+	}
+	dto.JwksUrl = model.OidcIDToken.JwksURL.ValueString()
+
+	dto.MatchRules = make([]aembit.TrustProviderMatchRuleDTO, 0)
+	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.OidcIDToken.Issuer, "OidcIssuer")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.OidcIDToken.Audience, model.OidcIDToken.Audiences, "OidcAudience")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.OidcIDToken.Subject, model.OidcIDToken.Subjects, "OidcSubject")
+	claims := make(map[string]string)
+	_ = model.OidcIDToken.Claims.ElementsAs(ctx, &claims, true)
+	names := make([]string, 0, len(claims))
+	for name := range claims {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		dto.MatchRules = append(dto.MatchRules, aembit.TrustProviderMatchRuleDTO{
+			Attribute: oidcClaimMatchRulePrefix + name, Value: claims[name],
+		})
+	}
+	dto.MatchRules = appendMatchRuleModels(dto.MatchRules, model.OidcIDToken.MatchRules)
 }
 
 func convertTerraformModelToDTO(model trustProviderResourceModel, dto *aembit.TrustProviderDTO) {
 	dto.Provider = "TerraformIdentityToken"
 
 	dto.MatchRules = make([]aembit.TrustProviderMatchRuleDTO, 0)
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.TerraformWorkspace.OrganizationID, "TerraformOrganizationId")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.TerraformWorkspace.ProjectID, "TerraformProjectId")
-	dto.MatchRules = appendMatchRuleIfExists(dto.MatchRules, model.TerraformWorkspace.WorkspaceID, "TerraformWorkspaceId")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.TerraformWorkspace.OrganizationID, model.TerraformWorkspace.OrganizationIDs, "TerraformOrganizationId")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.TerraformWorkspace.ProjectID, model.TerraformWorkspace.ProjectIDs, "TerraformProjectId")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.TerraformWorkspace.WorkspaceID, model.TerraformWorkspace.WorkspaceIDs, "TerraformWorkspaceId")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.TerraformWorkspace.OrganizationName, model.TerraformWorkspace.OrganizationNames, "TerraformOrganizationName")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.TerraformWorkspace.ProjectName, model.TerraformWorkspace.ProjectNames, "TerraformProjectName")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.TerraformWorkspace.WorkspaceName, model.TerraformWorkspace.WorkspaceNames, "TerraformWorkspaceName")
+	dto.MatchRules = appendMatchRulesIfExists(dto.MatchRules, model.TerraformWorkspace.RunPhase, model.TerraformWorkspace.RunPhases, "TerraformRunPhase")
+	dto.MatchRules = appendMatchRuleModels(dto.MatchRules, model.TerraformWorkspace.MatchRules)
 }
 
 // DTO to Model conversion methods.
-func convertTrustProviderDTOToModel(ctx context.Context, dto aembit.TrustProviderDTO) trustProviderResourceModel {
+// The state is used to keep match rules which were configured as a set of values in the set, even with a single value.
+// Match rules configured with match_rules, and match rules without a typed attribute, are kept in match_rules.
+func convertTrustProviderDTOToModel(ctx context.Context, dto aembit.TrustProviderDTO, state trustProviderResourceModel) trustProviderResourceModel {
 	var model trustProviderResourceModel
 	model.ID = types.StringValue(dto.EntityDTO.ExternalID)
 	model.Name = types.StringValue(dto.EntityDTO.Name)
 	model.Description = types.StringValue(dto.EntityDTO.Description)
 	model.IsActive = types.BoolValue(dto.EntityDTO.IsActive)
 	model.Tags = newTagsModel(ctx, dto.EntityDTO.Tags)
+	model.ClientIDType = getTrustProviderClientIDType(dto.Provider)
 
 	switch dto.Provider {
 	case "AWSECSRole":
-		model.AwsEcsRole = convertAwsEcsRoleDTOToModel(dto)
+		model.AwsEcsRole = convertAwsEcsRoleDTOToModel(dto, state.AwsEcsRole)
 	case "AWSMetadataService":
-		model.AwsMetadata = convertAwsMetadataDTOToModel(dto)
+		model.AwsMetadata = convertAwsMetadataDTOToModel(dto, state.AwsMetadata)
+	case "AWSRole":
+		model.AwsRole = convertAwsRoleDTOToModel(dto, state.AwsRole)
 	case "AzureMetadataService":
-		model.AzureMetadata = convertAzureMetadataDTOToModel(dto)
+		model.AzureMetadata = convertAzureMetadataDTOToModel(dto, state.AzureMetadata)
 	case "GcpIdentityToken":
-		model.GcpIdentity = convertGcpIdentityDTOToModel(dto)
+		model.GcpIdentity = convertGcpIdentityDTOToModel(dto, state.GcpIdentity)
 	case "GitHubIdentityToken":
-		model.GitHubAction = convertGitHubActionDTOToModel(dto)
+		model.GitHubAction = convertGitHubActionDTOToModel(dto, state.GitHubAction)
+	case "GitLabIdentityToken":
+		model.GitLabJob = convertGitLabJobDTOToModel(dto, state.GitLabJob)
 	case "Kerberos":
-		model.Kerberos = convertKerberosDTOToModel(dto)
+		model.Kerberos = convertKerberosDTOToModel(dto, state.Kerberos)
 	case "KubernetesServiceAccount":
-		model.KubernetesService = convertKubernetesDTOToModel(dto)
+		model.KubernetesService = convertKubernetesDTOToModel(dto, state.KubernetesService)
+	case "OidcIdToken":
+		model.OidcIDToken = convertOidcIDTokenDTOToModel(ctx, dto, state.OidcIDToken)
 	case "TerraformIdentityToken":
-		model.TerraformWorkspace = convertTerraformDTOToModel(dto)
+		model.TerraformWorkspace = convertTerraformDTOToModel(dto, state.TerraformWorkspace)
 	}
 
 	return model
 }
 
-func convertAzureMetadataDTOToModel(dto aembit.TrustProviderDTO) *trustProviderAzureMetadataModel {
-	model := &trustProviderAzureMetadataModel{
-		Sku:            types.StringNull(),
-		VMID:           types.StringNull(),
-		SubscriptionID: types.StringNull(),
+// matchRuleSet holds the match rules of a trust provider while they are converted to the typed attributes.
+type matchRuleSet struct {
+	values     map[string][]string
+	configured []trustProviderMatchRuleModel
+	keepEmpty  bool
+}
+
+// newMatchRuleSet groups the match rule values by attribute. Rules which the state holds in match_rules stay there,
+// in the order of the state.
+func newMatchRuleSet(dto aembit.TrustProviderDTO, stateRules types.Set) *matchRuleSet {
+	rules := &matchRuleSet{values: make(map[string][]string), keepEmpty: !stateRules.IsNull() && !stateRules.IsUnknown()}
+	configured := make([]bool, len(dto.MatchRules))
+	for _, stateRule := range matchRuleModels(stateRules) {
+		for i, rule := range dto.MatchRules {
+			if !configured[i] && stateRule.Attribute.ValueString() == rule.Attribute && stateRule.Value.ValueString() == rule.Value {
+				configured[i] = true
+				rules.configured = append(rules.configured, trustProviderMatchRuleModel{
+					Attribute: types.StringValue(rule.Attribute),
+					Value:     types.StringValue(rule.Value),
+				})
+				break
+			}
+		}
+	}
+	for i, rule := range dto.MatchRules {
+		if !configured[i] {
+			rules.values[rule.Attribute] = append(rules.values[rule.Attribute], rule.Value)
+		}
+	}
+	return rules
+}
+
+// take returns the values of a match attribute which has a typed attribute, and removes them from the set.
+func (r *matchRuleSet) take(attribute string) []string {
+	values := r.values[attribute]
+	delete(r.values, attribute)
+	return values
+}
+
+// takePrefix returns the last value of each match attribute with the prefix, keyed by the rest of the attribute name,
+// and removes them from the set.
+func (r *matchRuleSet) takePrefix(prefix string) map[string]string {
+	values := make(map[string]string)
+	for attribute := range r.values {
+		if name, found := strings.CutPrefix(attribute, prefix); found {
+			values[name] = lastMatchRuleValue(r.take(attribute)).ValueString()
+		}
 	}
+	return values
+}
 
-	for _, rule := range dto.MatchRules {
-		switch rule.Attribute {
-		case "AzureSku":
-			model.Sku = types.StringValue(rule.Value)
-		case "AzureVmId":
-			model.VMID = types.StringValue(rule.Value)
-		case "AzureSubscriptionId":
-			model.SubscriptionID = types.StringValue(rule.Value)
+// remaining returns the match rules without a typed attribute, for match_rules. Without any, match_rules is null
+// unless the state holds an empty set.
+func (r *matchRuleSet) remaining() types.Set {
+	rules := r.configured
+	attributes := make([]string, 0, len(r.values))
+	for attribute := range r.values {
+		attributes = append(attributes, attribute)
+	}
+	sort.Strings(attributes)
+	for _, attribute := range attributes {
+		for _, value := range r.values[attribute] {
+			rules = append(rules, trustProviderMatchRuleModel{
+				Attribute: types.StringValue(attribute),
+				Value:     types.StringValue(value),
+			})
 		}
 	}
-	return model
+	if len(rules) == 0 && !r.keepEmpty {
+		return types.SetNull(trustProviderMatchRuleType)
+	}
+	return newMatchRulesValue(rules)
+}
+
+// newMatchRulesValue returns the match_rules set of the match rules.
+func newMatchRulesValue(rules []trustProviderMatchRuleModel) types.Set {
+	elements := make([]attr.Value, len(rules))
+	for i, rule := range rules {
+		elements[i] = types.ObjectValueMust(trustProviderMatchRuleType.AttrTypes, map[string]attr.Value{
+			"attribute": rule.Attribute,
+			"value":     rule.Value,
+		})
+	}
+	return types.SetValueMust(trustProviderMatchRuleType, elements)
 }
 
-func convertAwsEcsRoleDTOToModel(dto aembit.TrustProviderDTO) *trustProviderAwsEcsRoleModel {
-	model := &trustProviderAwsEcsRoleModel{
-		AccountID:   types.StringNull(),
-		AssumedRole: types.StringNull(),
-		RoleARN:     types.StringNull(),
-		Username:    types.StringNull(),
-	}
-
-	for _, rule := range dto.MatchRules {
-		switch rule.Attribute {
-		case "AwsAccountId":
-			model.AccountID = types.StringValue(rule.Value)
-		case "AwsAssumedRole":
-			model.AssumedRole = types.StringValue(rule.Value)
-		case "AwsRoleARN":
-			model.RoleARN = types.StringValue(rule.Value)
-		case "AwsUsername":
-			model.Username = types.StringValue(rule.Value)
+// matchRuleModels returns the match rules of a match_rules set, or none if it is null or unknown.
+func matchRuleModels(rules types.Set) []trustProviderMatchRuleModel {
+	if rules.IsNull() || rules.IsUnknown() {
+		return nil
+	}
+
+	models := make([]trustProviderMatchRuleModel, 0, len(rules.Elements()))
+	for _, element := range rules.Elements() {
+		rule, ok := element.(types.Object)
+		if !ok {
+			continue
 		}
+		attribute, _ := rule.Attributes()["attribute"].(types.String)
+		value, _ := rule.Attributes()["value"].(types.String)
+		models = append(models, trustProviderMatchRuleModel{Attribute: attribute, Value: value})
+	}
+	return models
+}
+
+// convertMatchRuleValues returns a single match rule value, or the set of values when there are several values
+// or the state holds the values as a set.
+func convertMatchRuleValues(values []string, stateValues []types.String) (types.String, []types.String) {
+	if len(values) == 0 {
+		return types.StringNull(), nil
+	}
+	if len(values) == 1 && stateValues == nil {
+		return types.StringValue(values[0]), nil
+	}
+
+	setValues := make([]types.String, len(values))
+	for i, value := range values {
+		setValues[i] = types.StringValue(value)
+	}
+	return types.StringNull(), setValues
+}
+
+// lastMatchRuleValue returns the last value of a match rule which only holds a single value.
+func lastMatchRuleValue(values []string) types.String {
+	if len(values) == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(values[len(values)-1])
+}
+
+func convertAzureMetadataDTOToModel(dto aembit.TrustProviderDTO, state *trustProviderAzureMetadataModel) *trustProviderAzureMetadataModel {
+	if state == nil {
+		state = &trustProviderAzureMetadataModel{}
+	}
+	rules := newMatchRuleSet(dto, state.MatchRules)
+
+	model := &trustProviderAzureMetadataModel{}
+	model.Sku, model.Skus = convertMatchRuleValues(rules.take("AzureSku"), state.Skus)
+	model.VMID, model.VMIDs = convertMatchRuleValues(rules.take("AzureVmId"), state.VMIDs)
+	model.SubscriptionID, model.SubscriptionIDs = convertMatchRuleValues(rules.take("AzureSubscriptionId"), state.SubscriptionIDs)
+	model.MatchRules = rules.remaining()
+	return model
+}
+
+func convertAwsEcsRoleDTOToModel(dto aembit.TrustProviderDTO, state *trustProviderAwsEcsRoleModel) *trustProviderAwsEcsRoleModel {
+	if state == nil {
+		state = &trustProviderAwsEcsRoleModel{}
 	}
+	rules := newMatchRuleSet(dto, state.MatchRules)
+
+	model := &trustProviderAwsEcsRoleModel{}
+	model.AccountID, model.AccountIDs = convertMatchRuleValues(rules.take("AwsAccountId"), state.AccountIDs)
+	model.AssumedRole, model.AssumedRoles = convertMatchRuleValues(rules.take("AwsAssumedRole"), state.AssumedRoles)
+	model.RoleARN, model.RoleARNs = convertMatchRuleValues(rules.take("AwsRoleARN"), state.RoleARNs)
+	model.Username, model.Usernames = convertMatchRuleValues(rules.take("AwsUsername"), state.Usernames)
+	model.MatchRules = rules.remaining()
 	return model
 }
 
-func convertAwsMetadataDTOToModel(dto aembit.TrustProviderDTO) *trustProviderAwsMetadataModel {
+func convertAwsMetadataDTOToModel(dto aembit.TrustProviderDTO, state *trustProviderAwsMetadataModel) *trustProviderAwsMetadataModel {
+	if state == nil {
+		state = &trustProviderAwsMetadataModel{}
+	}
+	rules := newMatchRuleSet(dto, state.MatchRules)
 	decodedCert, _ := base64.StdEncoding.DecodeString(dto.Certificate)
 
 	model := &trustProviderAwsMetadataModel{
-		Certificate:             types.StringValue(string(decodedCert)),
-		AccountID:               types.StringNull(),
-		Architecture:            types.StringNull(),
-		AvailabilityZone:        types.StringNull(),
-		BillingProducts:         types.StringNull(),
-		ImageID:                 types.StringNull(),
-		InstanceID:              types.StringNull(),
-		InstanceType:            types.StringNull(),
-		KernelID:                types.StringNull(),
-		MarketplaceProductCodes: types.StringNull(),
-		PendingTime:             types.StringNull(),
-		PrivateIP:               types.StringNull(),
-		RamdiskID:               types.StringNull(),
-		Region:                  types.StringNull(),
-		Version:                 types.StringNull(),
-	}
-
-	for _, rule := range dto.MatchRules {
-		switch rule.Attribute {
-		case "AwsAccountId":
-			model.AccountID = types.StringValue(rule.Value)
-		case "AwsArchitecture":
-			model.Architecture = types.StringValue(rule.Value)
-		case "AwsAvailabilityZone":
-			model.AvailabilityZone = types.StringValue(rule.Value)
-		case "AwsBillingProducts":
-			model.BillingProducts = types.StringValue(rule.Value)
-		case "AwsImageId":
-			model.ImageID = types.StringValue(rule.Value)
-		case "AwsInstanceId":
-			model.InstanceID = types.StringValue(rule.Value)
-		case "AwsInstanceType":
-			model.InstanceType = types.StringValue(rule.Value)
-		case "AwsKernelId":
-			model.KernelID = types.StringValue(rule.Value)
-		case "AwsMarketplaceProductCodes":
-			model.MarketplaceProductCodes = types.StringValue(rule.Value)
-		case "AwsPendingTime":
-			model.PendingTime = types.StringValue(rule.Value)
-		case "AwsPrivateIp":
-			model.PrivateIP = types.StringValue(rule.Value)
-		case "AwsRamdiskId":
-			model.RamdiskID = types.StringValue(rule.Value)
-		case "AwsRegion":
-			model.Region = types.StringValue(rule.Value)
-		case "AwsVersion":
-			model.Version = types.StringValue(rule.Value)
-		}
+		Certificate:             newPEMNull(),
+		CertificateRegion:       types.StringNull(),
+		BillingProducts:         lastMatchRuleValue(rules.take("AwsBillingProducts")),
+		MarketplaceProductCodes: lastMatchRuleValue(rules.take("AwsMarketplaceProductCodes")),
+	}
+	// Keep the configured region while its embedded certificate is still the one in use.
+	if region := state.CertificateRegion.ValueString(); len(region) > 0 && pemBlocksEqual(awsIMDSCertificates[region], string(decodedCert)) {
+		model.CertificateRegion = state.CertificateRegion
+	} else if len(decodedCert) > 0 {
+		model.Certificate = newPEMValue(string(decodedCert))
+	}
+	model.AccountID, model.AccountIDs = convertMatchRuleValues(rules.take("AwsAccountId"), state.AccountIDs)
+	model.Architecture, model.Architectures = convertMatchRuleValues(rules.take("AwsArchitecture"), state.Architectures)
+	model.AvailabilityZone, model.AvailabilityZones = convertMatchRuleValues(rules.take("AwsAvailabilityZone"), state.AvailabilityZones)
+	model.ImageID, model.ImageIDs = convertMatchRuleValues(rules.take("AwsImageId"), state.ImageIDs)
+	model.InstanceID, model.InstanceIDs = convertMatchRuleValues(rules.take("AwsInstanceId"), state.InstanceIDs)
+	model.InstanceType, model.InstanceTypes = convertMatchRuleValues(rules.take("AwsInstanceType"), state.InstanceTypes)
+	model.KernelID, model.KernelIDs = convertMatchRuleValues(rules.take("AwsKernelId"), state.KernelIDs)
+	model.PendingTime, model.PendingTimes = convertMatchRuleValues(rules.take("AwsPendingTime"), state.PendingTimes)
+	model.PrivateIP, model.PrivateIPs = convertMatchRuleValues(rules.take("AwsPrivateIp"), state.PrivateIPs)
+	model.RamdiskID, model.RamdiskIDs = convertMatchRuleValues(rules.take("AwsRamdiskId"), state.RamdiskIDs)
+	model.Region, model.Regions = convertMatchRuleValues(rules.take("AwsRegion"), state.Regions)
+	model.Version, model.Versions = convertMatchRuleValues(rules.take("AwsVersion"), state.Versions)
+	model.MatchRules = rules.remaining()
+	return model
+}
+
+func convertAwsRoleDTOToModel(dto aembit.TrustProviderDTO, state *trustProviderAwsRoleModel) *trustProviderAwsRoleModel {
+	if state == nil {
+		state = &trustProviderAwsRoleModel{}
 	}
+	rules := newMatchRuleSet(dto, state.MatchRules)
+
+	model := &trustProviderAwsRoleModel{}
+	model.AccountID, model.AccountIDs = convertMatchRuleValues(rules.take("AwsAccountId"), state.AccountIDs)
+	model.AssumedRole, model.AssumedRoles = convertMatchRuleValues(rules.take("AwsAssumedRole"), state.AssumedRoles)
+	model.RoleARN, model.RoleARNs = convertMatchRuleValues(rules.take("AwsRoleARN"), state.RoleARNs)
+	model.Username, model.Usernames = convertMatchRuleValues(rules.take("AwsUsername"), state.Usernames)
+	model.MatchRules = rules.remaining()
 	return model
 }
 
-func convertKerberosDTOToModel(dto aembit.TrustProviderDTO) *trustProviderKerberosModel {
-	model := &trustProviderKerberosModel{
-		Principal: types.StringNull(),
-		Realm:     types.StringNull(),
-		SourceIP:  types.StringNull(),
+func convertKerberosDTOToModel(dto aembit.TrustProviderDTO, state *trustProviderKerberosModel) *trustProviderKerberosModel {
+	if state == nil {
+		state = &trustProviderKerberosModel{}
 	}
+	rules := newMatchRuleSet(dto, state.MatchRules)
+
+	model := &trustProviderKerberosModel{}
 	model.AgentControllerIDs = make([]types.String, len(dto.AgentControllerIDs))
 	for i, controllerID := range dto.AgentControllerIDs {
 		model.AgentControllerIDs[i] = types.StringValue(controllerID)
 	}
+	model.Principal, model.Principals = convertMatchRuleValues(rules.take("Principal"), state.Principals)
+	model.Realm, model.Realms = convertMatchRuleValues(rules.take("Realm"), state.Realms)
+	model.SourceIP, model.SourceIPs = convertMatchRuleValues(rules.take("SourceIp"), state.SourceIPs)
+	model.MatchRules = rules.remaining()
+	return model
+}
+
+func convertKubernetesDTOToModel(dto aembit.TrustProviderDTO, state *trustProviderKubernetesModel) *trustProviderKubernetesModel {
+	if state == nil {
+		state = &trustProviderKubernetesModel{}
+	}
+	rules := newMatchRuleSet(dto, state.MatchRules)
+	decodedKey, _ := base64.StdEncoding.DecodeString(dto.Certificate)
 
-	for _, rule := range dto.MatchRules {
-		switch rule.Attribute {
-		case "Principal":
-			model.Principal = types.StringValue(rule.Value)
-		case "Realm":
-			model.Realm = types.StringValue(rule.Value)
-		case "SourceIp":
-			model.SourceIP = types.StringValue(rule.Value)
+	model := &trustProviderKubernetesModel{
+		PublicKey:    newPEMNull(),
+		Jwks:         types.StringNull(),
+		OIDCEndpoint: types.StringNull(),
+	}
+	if len(dto.Certificate) > 0 {
+		// Aembit only stores the public keys converted from the JWKS, so the JWKS is kept from the state while it matches them.
+		if jwksKeys, err := convertJWKSToPEM(state.Jwks.ValueString()); err == nil && pemBlocksEqual(jwksKeys, string(decodedKey)) {
+			model.Jwks = state.Jwks
+		} else {
+			model.PublicKey = newPEMValue(string(decodedKey))
 		}
 	}
+	if len(dto.OidcUrl) > 0 {
+		model.OIDCEndpoint = types.StringValue(dto.OidcUrl)
+	}
+	model.Issuer, model.Issuers = convertMatchRuleValues(rules.take("KubernetesIss"), state.Issuers)
+	model.Namespace, model.Namespaces = convertMatchRuleValues(rules.take("KubernetesIoNamespace"), state.Namespaces)
+	model.PodName, model.PodNames = convertMatchRuleValues(rules.take("KubernetesIoPodName"), state.PodNames)
+	model.ServiceAccountName, model.ServiceAccountNames = convertMatchRuleValues(rules.take("KubernetesIoServiceAccountName"), state.ServiceAccountNames)
+	model.Subject, model.Subjects = convertMatchRuleValues(rules.take("KubernetesSub"), state.Subjects)
+	model.MatchRules = rules.remaining()
 	return model
 }
 
-func convertKubernetesDTOToModel(dto aembit.TrustProviderDTO) *trustProviderKubernetesModel {
+func convertOidcIDTokenDTOToModel(ctx context.Context, dto aembit.TrustProviderDTO, state *trustProviderOidcIDTokenModel) *trustProviderOidcIDTokenModel {
+	if state == nil {
+		state = &trustProviderOidcIDTokenModel{}
+	}
+	rules := newMatchRuleSet(dto, state.MatchRules)
 	decodedKey, _ := base64.StdEncoding.DecodeString(dto.Certificate)
 
-	model := &trustProviderKubernetesModel{
-		Issuer:             types.StringNull(),
-		Namespace:          types.StringNull(),
-		PodName:            types.StringNull(),
-		ServiceAccountName: types.StringNull(),
-		Subject:            types.StringNull(),
-		PublicKey:          types.StringNull(),
-		OIDCEndpoint:       types.StringNull(),
+	model := &trustProviderOidcIDTokenModel{
+		PublicKey: newPEMNull(),
+		JwksURL:   types.StringNull(),
+		Claims:    types.MapNull(types.StringType),
 	}
 	if len(dto.Certificate) > 0 {
-		model.PublicKey = types.StringValue(string(decodedKey))
+		model.PublicKey = newPEMValue(string(decodedKey))
 	} else {
-		model.OIDCEndpoint = types.StringValue(dto.OidcUrl)
+		model.JwksURL = types.StringValue(dto.JwksUrl)
 	}
-
-	for _, rule := range dto.MatchRules {
-		switch rule.Attribute {
-		case "KubernetesIss":
-			model.Issuer = types.StringValue(rule.Value)
-		case "KubernetesIoNamespace":
-			model.Namespace = types.StringValue(rule.Value)
-		case "KubernetesIoPodName":
-			model.PodName = types.StringValue(rule.Value)
-		case "KubernetesIoServiceAccountName":
-			model.ServiceAccountName = types.StringValue(rule.Value)
-		case "KubernetesSub":
-			model.Subject = types.StringValue(rule.Value)
-		}
+	model.Issuer = lastMatchRuleValue(rules.take("OidcIssuer"))
+	model.Audience, model.Audiences = convertMatchRuleValues(rules.take("OidcAudience"), state.Audiences)
+	model.Subject, model.Subjects = convertMatchRuleValues(rules.take("OidcSubject"), state.Subjects)
+	if claims := rules.takePrefix(oidcClaimMatchRulePrefix); len(claims) > 0 {
+		model.Claims, _ = types.MapValueFrom(ctx, types.StringType, claims)
 	}
+	model.MatchRules = rules.remaining()
 	return model
 }
 
-func convertGcpIdentityDTOToModel(dto aembit.TrustProviderDTO) *trustProviderGcpIdentityModel {
-	model := &trustProviderGcpIdentityModel{
-		EMail: types.StringNull(),
+func convertGcpIdentityDTOToModel(dto aembit.TrustProviderDTO, state *trustProviderGcpIdentityModel) *trustProviderGcpIdentityModel {
+	if state == nil {
+		state = &trustProviderGcpIdentityModel{}
 	}
+	rules := newMatchRuleSet(dto, state.MatchRules)
 
-	for _, rule := range dto.MatchRules {
-		switch rule.Attribute {
-		case "Email":
-			model.EMail = types.StringValue(rule.Value)
-		}
-	}
+	model := &trustProviderGcpIdentityModel{}
+	model.EMail, model.EMails = convertMatchRuleValues(rules.take("Email"), state.EMails)
+	model.MatchRules = rules.remaining()
 	return model
 }
 
-func convertGitHubActionDTOToModel(dto aembit.TrustProviderDTO) *trustProviderGitHubActionModel {
-	model := &trustProviderGitHubActionModel{
-		Actor:      types.StringNull(),
-		Repository: types.StringNull(),
-		Workflow:   types.StringNull(),
+func convertGitHubActionDTOToModel(dto aembit.TrustProviderDTO, state *trustProviderGitHubActionModel) *trustProviderGitHubActionModel {
+	if state == nil {
+		state = &trustProviderGitHubActionModel{}
 	}
+	rules := newMatchRuleSet(dto, state.MatchRules)
 
-	for _, rule := range dto.MatchRules {
-		switch rule.Attribute {
-		case "GithubActor":
-			model.Actor = types.StringValue(rule.Value)
-		case "GithubRepository":
-			model.Repository = types.StringValue(rule.Value)
-		case "GithubWorkflow":
-			model.Workflow = types.StringValue(rule.Value)
-		}
+	model := &trustProviderGitHubActionModel{Issuer: types.StringValue(gitHubActionIssuer)}
+	if len(dto.OidcUrl) > 0 {
+		model.Issuer = types.StringValue(dto.OidcUrl)
 	}
+	model.Actor, model.Actors = convertMatchRuleValues(rules.take("GithubActor"), state.Actors)
+	model.Repository, model.Repositories = convertMatchRuleValues(rules.take("GithubRepository"), state.Repositories)
+	model.Workflow, model.Workflows = convertMatchRuleValues(rules.take("GithubWorkflow"), state.Workflows)
+	model.Ref, model.Refs = convertMatchRuleValues(rules.take("GithubRef"), state.Refs)
+	model.Environment, model.Environments = convertMatchRuleValues(rules.take("GithubEnvironment"), state.Environments)
+	model.RepositoryOwner, model.RepositoryOwners = convertMatchRuleValues(rules.take("GithubRepositoryOwner"), state.RepositoryOwners)
+	model.JobWorkflowRef, model.JobWorkflowRefs = convertMatchRuleValues(rules.take("GithubJobWorkflowRef"), state.JobWorkflowRefs)
+	model.MatchRules = rules.remaining()
 	return model
 }
 
-func convertTerraformDTOToModel(dto aembit.TrustProviderDTO) *trustProviderTerraformModel {
-	model := &trustProviderTerraformModel{
-		OrganizationID: types.StringNull(),
-		ProjectID:      types.StringNull(),
-		WorkspaceID:    types.StringNull(),
+func convertGitLabJobDTOToModel(dto aembit.TrustProviderDTO, state *trustProviderGitLabJobModel) *trustProviderGitLabJobModel {
+	if state == nil {
+		state = &trustProviderGitLabJobModel{}
 	}
+	rules := newMatchRuleSet(dto, state.MatchRules)
+
+	model := &trustProviderGitLabJobModel{OIDCEndpoint: types.StringValue(dto.OidcUrl)}
+	model.NamespacePath, model.NamespacePaths = convertMatchRuleValues(rules.take("GitLabNamespacePath"), state.NamespacePaths)
+	model.ProjectPath, model.ProjectPaths = convertMatchRuleValues(rules.take("GitLabProjectPath"), state.ProjectPaths)
+	model.RefPath, model.RefPaths = convertMatchRuleValues(rules.take("GitLabRefPath"), state.RefPaths)
+	model.UserLogin, model.UserLogins = convertMatchRuleValues(rules.take("GitLabUserLogin"), state.UserLogins)
+	model.MatchRules = rules.remaining()
+	return model
+}
 
-	for _, rule := range dto.MatchRules {
-		switch rule.Attribute {
-		case "TerraformOrganizationId":
-			model.OrganizationID = types.StringValue(rule.Value)
-		case "TerraformProjectId":
-			model.ProjectID = types.StringValue(rule.Value)
-		case "TerraformWorkspaceId":
-			model.WorkspaceID = types.StringValue(rule.Value)
-		}
+func convertTerraformDTOToModel(dto aembit.TrustProviderDTO, state *trustProviderTerraformModel) *trustProviderTerraformModel {
+	if state == nil {
+		state = &trustProviderTerraformModel{}
 	}
+	rules := newMatchRuleSet(dto, state.MatchRules)
+
+	model := &trustProviderTerraformModel{}
+	model.OrganizationID, model.OrganizationIDs = convertMatchRuleValues(rules.take("TerraformOrganizationId"), state.OrganizationIDs)
+	model.ProjectID, model.ProjectIDs = convertMatchRuleValues(rules.take("TerraformProjectId"), state.ProjectIDs)
+	model.WorkspaceID, model.WorkspaceIDs = convertMatchRuleValues(rules.take("TerraformWorkspaceId"), state.WorkspaceIDs)
+	model.OrganizationName, model.OrganizationNames = convertMatchRuleValues(rules.take("TerraformOrganizationName"), state.OrganizationNames)
+	model.ProjectName, model.ProjectNames = convertMatchRuleValues(rules.take("TerraformProjectName"), state.ProjectNames)
+	model.WorkspaceName, model.WorkspaceNames = convertMatchRuleValues(rules.take("TerraformWorkspaceName"), state.WorkspaceNames)
+	model.RunPhase, model.RunPhases = convertMatchRuleValues(rules.take("TerraformRunPhase"), state.RunPhases)
+	model.MatchRules = rules.remaining()
 	return model
 }
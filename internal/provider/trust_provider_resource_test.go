@@ -1,9 +1,13 @@
 package provider
 
 import (
+	"context"
+	"encoding/base64"
 	"os"
 	"testing"
 
+	"aembit.io/aembit"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -85,6 +89,48 @@ func TestAccTrustProviderResource_AwsEcsRole(t *testing.T) {
 	})
 }
 
+func TestAccTrustProviderResource_AwsRole(t *testing.T) {
+	createFile, _ := os.ReadFile("../../tests/trust/aws_role/TestAccTrustProviderResource.tf")
+	modifyFile, _ := os.ReadFile("../../tests/trust/aws_role/TestAccTrustProviderResource.tfmod")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: string(createFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify Trust Provider Name
+					resource.TestCheckResourceAttr("aembit_trust_provider.aws_role", "name", "TF Acceptance AWS Role"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("aembit_trust_provider.aws_role", "id"),
+					// Verify the AWS Role match rules
+					resource.TestCheckResourceAttr("aembit_trust_provider.aws_role", "aws_role.role_arn", "role_arn"),
+					// Verify placeholder ID is set
+					resource.TestCheckResourceAttrSet("aembit_trust_provider.aws_role", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "aembit_trust_provider.aws_role",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: string(modifyFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify Name updated
+					resource.TestCheckResourceAttr("aembit_trust_provider.aws_role", "name", "TF Acceptance AWS Role - Modified"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("aembit_trust_provider.aws_role", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func TestAccTrustProviderResource_AwsMetadata(t *testing.T) {
 	createFile, _ := os.ReadFile("../../tests/trust/aws/TestAccTrustProviderResource.tf")
 	modifyFile, _ := os.ReadFile("../../tests/trust/aws/TestAccTrustProviderResource.tfmod")
@@ -176,6 +222,9 @@ func TestAccTrustProviderResource_GitHubAction(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify Trust Provider Name
 					resource.TestCheckResourceAttr("aembit_trust_provider.github", "name", "TF Acceptance GitHub Action"),
+					// Verify the github.com issuer by default
+					resource.TestCheckResourceAttr("aembit_trust_provider.github", "github_action.issuer", "https://token.actions.githubusercontent.com"),
+					resource.TestCheckResourceAttr("aembit_trust_provider.github", "client_id_type", "github_idtoken"),
 					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet("aembit_trust_provider.github", "id"),
 					// Verify placeholder ID is set
@@ -194,6 +243,10 @@ func TestAccTrustProviderResource_GitHubAction(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify Name updated
 					resource.TestCheckResourceAttr("aembit_trust_provider.github", "name", "TF Acceptance GitHub Action - Modified"),
+					// Verify the GitHub Enterprise Server issuer and extended claims
+					resource.TestCheckResourceAttr("aembit_trust_provider.github", "github_action.issuer", "https://github.example.com/_services/token"),
+					resource.TestCheckResourceAttr("aembit_trust_provider.github", "github_action.ref", "refs/heads/main"),
+					resource.TestCheckResourceAttr("aembit_trust_provider.github", "github_action.repository_owner", "repository_owner"),
 					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet("aembit_trust_provider.github", "id"),
 				),
@@ -203,6 +256,197 @@ func TestAccTrustProviderResource_GitHubAction(t *testing.T) {
 	})
 }
 
+func TestAccTrustProviderResource_GitHubActionRepositories(t *testing.T) {
+	createFile, _ := os.ReadFile("../../tests/trust/github/TestAccTrustProviderResourceRepositories.tf")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: string(createFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify each repository is kept in the set
+					resource.TestCheckResourceAttr("aembit_trust_provider.github", "github_action.repositories.#", "3"),
+					resource.TestCheckTypeSetElemAttr("aembit_trust_provider.github", "github_action.repositories.*", "owner/repository2"),
+					resource.TestCheckNoResourceAttr("aembit_trust_provider.github", "github_action.repository"),
+					resource.TestCheckResourceAttr("aembit_trust_provider.github", "github_action.actor", "actor"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "aembit_trust_provider.github",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestConvertMatchRuleValues(t *testing.T) {
+	dto := aembit.TrustProviderDTO{
+		Provider: "KubernetesServiceAccount",
+		MatchRules: []aembit.TrustProviderMatchRuleDTO{
+			{Attribute: "KubernetesIoNamespace", Value: "one"},
+			{Attribute: "KubernetesIoNamespace", Value: "two"},
+			{Attribute: "KubernetesIoPodName", Value: "pod"},
+			{Attribute: "KubernetesSub", Value: "subject"},
+		},
+	}
+	state := &trustProviderKubernetesModel{Subjects: []types.String{types.StringValue("subject")}}
+
+	model := convertKubernetesDTOToModel(dto, state)
+	if !model.Namespace.IsNull() || len(model.Namespaces) != 2 {
+		t.Errorf("expected multiple namespaces as a set, got %v and %v", model.Namespace, model.Namespaces)
+	}
+	if model.PodName.ValueString() != "pod" || model.PodNames != nil {
+		t.Errorf("expected a single pod name, got %v and %v", model.PodName, model.PodNames)
+	}
+	if !model.Subject.IsNull() || len(model.Subjects) != 1 {
+		t.Errorf("expected a single subject configured as a set to stay a set, got %v and %v", model.Subject, model.Subjects)
+	}
+	if !model.Issuer.IsNull() || model.Issuers != nil {
+		t.Errorf("expected no issuer, got %v and %v", model.Issuer, model.Issuers)
+	}
+
+	var rules []aembit.TrustProviderMatchRuleDTO
+	rules = appendMatchRulesIfExists(rules, model.Namespace, model.Namespaces, "KubernetesIoNamespace")
+	rules = appendMatchRulesIfExists(rules, model.PodName, model.PodNames, "KubernetesIoPodName")
+	if len(rules) != 3 {
+		t.Errorf("expected one match rule per value, got %v", rules)
+	}
+}
+
+func TestConvertAwsMetadataCertificateRegion(t *testing.T) {
+	certificate := testAWSCertificate(t)
+	withAWSIMDSCertificates(t, map[string]string{"us-gov-west-1": certificate})
+
+	model := trustProviderResourceModel{AwsMetadata: &trustProviderAwsMetadataModel{
+		Certificate:       newPEMNull(),
+		CertificateRegion: types.StringValue("us-gov-west-1"),
+	}}
+	var dto aembit.TrustProviderDTO
+	convertAwsMetadataModelToDTO(model, &dto)
+	if decoded, _ := base64.StdEncoding.DecodeString(dto.Certificate); string(decoded) != certificate {
+		t.Fatalf("expected the embedded certificate of the region, got %q", decoded)
+	}
+
+	read := convertAwsMetadataDTOToModel(dto, model.AwsMetadata)
+	if read.CertificateRegion.ValueString() != "us-gov-west-1" || !read.Certificate.IsNull() {
+		t.Errorf("expected the region to be kept, got %v and %v", read.CertificateRegion, read.Certificate)
+	}
+
+	// Another certificate stored in Aembit Cloud replaces the region.
+	dto.Certificate = base64.StdEncoding.EncodeToString([]byte("-----BEGIN CERTIFICATE-----\nAAAA\n-----END CERTIFICATE-----\n"))
+	read = convertAwsMetadataDTOToModel(dto, model.AwsMetadata)
+	if !read.CertificateRegion.IsNull() || read.Certificate.IsNull() {
+		t.Errorf("expected the stored certificate instead of the region, got %v and %v", read.CertificateRegion, read.Certificate)
+	}
+}
+
+func TestConvertGenericMatchRules(t *testing.T) {
+	dto := aembit.TrustProviderDTO{
+		Provider: "GitHubIdentityToken",
+		MatchRules: []aembit.TrustProviderMatchRuleDTO{
+			{Attribute: "GithubRepository", Value: "owner/repo"},
+			{Attribute: "GithubRef", Value: "refs/heads/main"},
+			{Attribute: "GithubActor", Value: "octocat"},
+			{Attribute: "GithubEnvironment", Value: "production"},
+		},
+	}
+	state := &trustProviderGitHubActionModel{MatchRules: newMatchRulesValue([]trustProviderMatchRuleModel{
+		{Attribute: types.StringValue("GithubActor"), Value: types.StringValue("octocat")},
+		{Attribute: types.StringValue("GithubEnvironment"), Value: types.StringValue("production")},
+		{Attribute: types.StringValue("GithubRef"), Value: types.StringValue("refs/heads/main")},
+	})}
+
+	model := convertGitHubActionDTOToModel(dto, state)
+	matchRules := matchRuleModels(model.MatchRules)
+	if model.Repository.ValueString() != "owner/repo" {
+		t.Errorf("expected the repository as a typed attribute, got %v", model.Repository)
+	}
+	if !model.Actor.IsNull() || model.Actors != nil {
+		t.Errorf("expected the actor configured in match_rules to stay there, got %v and %v", model.Actor, model.Actors)
+	}
+	expected := []string{"GithubActor=octocat", "GithubEnvironment=production", "GithubRef=refs/heads/main"}
+	if len(matchRules) != len(expected) {
+		t.Fatalf("expected match rules %v, got %v", expected, matchRules)
+	}
+	for i, rule := range matchRules {
+		if rule.Attribute.ValueString()+"="+rule.Value.ValueString() != expected[i] {
+			t.Errorf("expected match rule %s, got %v", expected[i], rule)
+		}
+	}
+
+	var rules []aembit.TrustProviderMatchRuleDTO
+	rules = appendMatchRuleModels(rules, model.MatchRules)
+	if len(rules) != 3 || rules[1].Attribute != "GithubEnvironment" {
+		t.Errorf("expected the match rules to be written back, got %v", rules)
+	}
+}
+
+func TestConvertGitHubActionTypedClaims(t *testing.T) {
+	dto := aembit.TrustProviderDTO{
+		Provider: "GitHubIdentityToken",
+		MatchRules: []aembit.TrustProviderMatchRuleDTO{
+			{Attribute: "GithubRef", Value: "refs/heads/main"},
+			{Attribute: "GithubEnvironment", Value: "production"},
+			{Attribute: "GithubSha", Value: "ffac537e6cbbf934b08745a378932722df287a53"},
+		},
+	}
+
+	model := convertGitHubActionDTOToModel(dto, nil)
+	if model.Ref.ValueString() != "refs/heads/main" || model.Environment.ValueString() != "production" {
+		t.Errorf("expected the ref and environment as typed attributes, got %v and %v", model.Ref, model.Environment)
+	}
+	if matchRules := matchRuleModels(model.MatchRules); len(matchRules) != 1 || matchRules[0].Attribute.ValueString() != "GithubSha" {
+		t.Errorf("expected only the sha in match_rules, got %v", model.MatchRules)
+	}
+}
+
+func TestAccTrustProviderResource_GitLabJob(t *testing.T) {
+	createFile, _ := os.ReadFile("../../tests/trust/gitlab/TestAccTrustProviderResource.tf")
+	modifyFile, _ := os.ReadFile("../../tests/trust/gitlab/TestAccTrustProviderResource.tfmod")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: string(createFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify Trust Provider Name
+					resource.TestCheckResourceAttr("aembit_trust_provider.gitlab", "name", "TF Acceptance GitLab Job"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("aembit_trust_provider.gitlab", "id"),
+					// Verify the default GitLab instance
+					resource.TestCheckResourceAttr("aembit_trust_provider.gitlab", "gitlab_job.oidc_endpoint", "https://gitlab.com"),
+					// Verify placeholder ID is set
+					resource.TestCheckResourceAttrSet("aembit_trust_provider.gitlab", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "aembit_trust_provider.gitlab",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: string(modifyFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify Name updated
+					resource.TestCheckResourceAttr("aembit_trust_provider.gitlab", "name", "TF Acceptance GitLab Job - Modified"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("aembit_trust_provider.gitlab", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func TestAccTrustProviderResource_Kerberos(t *testing.T) {
 	createFile, _ := os.ReadFile("../../tests/trust/kerberos/TestAccTrustProviderResource.tf")
 	modifyFile, _ := os.ReadFile("../../tests/trust/kerberos/TestAccTrustProviderResource.tfmod")
@@ -307,6 +551,119 @@ func TestAccTrustProviderResource_KubernetesServiceAccount(t *testing.T) {
 	})
 }
 
+func TestAccTrustProviderResource_KubernetesServiceAccountJwks(t *testing.T) {
+	createFile, _ := os.ReadFile("../../tests/trust/kubernetes/TestAccTrustProviderResourceJwks.tf")
+	modifyFile, _ := os.ReadFile("../../tests/trust/kubernetes/TestAccTrustProviderResourceJwks.tfmod")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: string(createFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify Trust Provider Name
+					resource.TestCheckResourceAttr("aembit_trust_provider.kubernetes_jwks", "name", "TF Acceptance Kubernetes JWKS"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("aembit_trust_provider.kubernetes_jwks", "id"),
+					// Verify the JWKS is kept instead of the public keys converted from it
+					resource.TestCheckResourceAttrSet("aembit_trust_provider.kubernetes_jwks", "kubernetes_service_account.jwks"),
+					resource.TestCheckNoResourceAttr("aembit_trust_provider.kubernetes_jwks", "kubernetes_service_account.public_key"),
+					// Verify placeholder ID is set
+					resource.TestCheckResourceAttrSet("aembit_trust_provider.kubernetes_jwks", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "aembit_trust_provider.kubernetes_jwks",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported trust providers only have the public keys converted from the JWKS.
+				ImportStateVerifyIgnore: []string{"kubernetes_service_account.jwks", "kubernetes_service_account.public_key"},
+			},
+			// Update and Read testing
+			{
+				Config: string(modifyFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify Name updated
+					resource.TestCheckResourceAttr("aembit_trust_provider.kubernetes_jwks", "name", "TF Acceptance Kubernetes JWKS - Modified"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("aembit_trust_provider.kubernetes_jwks", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTrustProviderResource_OidcIDToken(t *testing.T) {
+	createFile, _ := os.ReadFile("../../tests/trust/oidc/TestAccTrustProviderResource.tf")
+	modifyFile, _ := os.ReadFile("../../tests/trust/oidc/TestAccTrustProviderResource.tfmod")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: string(createFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify Trust Provider Name
+					resource.TestCheckResourceAttr("aembit_trust_provider.oidc", "name", "TF Acceptance OIDC ID Token"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("aembit_trust_provider.oidc", "id"),
+					// Verify the OIDC ID Token claims
+					resource.TestCheckResourceAttr("aembit_trust_provider.oidc", "oidc_id_token.claims.group", "group"),
+					// Verify placeholder ID is set
+					resource.TestCheckResourceAttrSet("aembit_trust_provider.oidc", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "aembit_trust_provider.oidc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: string(modifyFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify Name updated
+					resource.TestCheckResourceAttr("aembit_trust_provider.oidc", "name", "TF Acceptance OIDC ID Token - Modified"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("aembit_trust_provider.oidc", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestConvertOidcIDTokenClaims(t *testing.T) {
+	ctx := context.Background()
+	claims, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"group": "admins", "tenant": "example"})
+	model := trustProviderResourceModel{OidcIDToken: &trustProviderOidcIDTokenModel{
+		Issuer:  types.StringValue("https://issuer.example.com"),
+		JwksURL: types.StringValue("https://issuer.example.com/jwks"),
+		Claims:  claims,
+	}}
+
+	dto, err := convertTrustProviderModelToDTO(ctx, model, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dto.Provider != "OidcIdToken" || len(dto.MatchRules) != 3 || dto.MatchRules[1].Attribute != "OidcClaim:group" {
+		t.Fatalf("expected the issuer and one match rule per claim, got %v", dto.MatchRules)
+	}
+
+	converted := convertTrustProviderDTOToModel(ctx, dto, model)
+	if !converted.OidcIDToken.Claims.Equal(claims) || !converted.OidcIDToken.MatchRules.IsNull() {
+		t.Errorf("expected the claims to be read back, got %v and %v", converted.OidcIDToken.Claims, converted.OidcIDToken.MatchRules)
+	}
+	if converted.OidcIDToken.Issuer.ValueString() != "https://issuer.example.com" || !converted.OidcIDToken.PublicKey.IsNull() {
+		t.Errorf("expected the issuer and JWKS URL to be read back, got %v", converted.OidcIDToken)
+	}
+}
+
 func TestAccTrustProviderResource_TerraformWorkspace(t *testing.T) {
 	createFile, _ := os.ReadFile("../../tests/trust/terraform/TestAccTrustProviderResource.tf")
 	modifyFile, _ := os.ReadFile("../../tests/trust/terraform/TestAccTrustProviderResource.tfmod")
@@ -338,6 +695,10 @@ func TestAccTrustProviderResource_TerraformWorkspace(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify Name updated
 					resource.TestCheckResourceAttr("aembit_trust_provider.terraform", "name", "TF Acceptance Terraform Workspace - Modified"),
+					resource.TestCheckResourceAttr("aembit_trust_provider.terraform", "terraform_workspace.organization_name", "organization_name"),
+					resource.TestCheckResourceAttr("aembit_trust_provider.terraform", "terraform_workspace.project_name", "project_name"),
+					resource.TestCheckResourceAttr("aembit_trust_provider.terraform", "terraform_workspace.workspace_names.#", "2"),
+					resource.TestCheckResourceAttr("aembit_trust_provider.terraform", "terraform_workspace.run_phase", "plan"),
 					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet("aembit_trust_provider.terraform", "id"),
 				),
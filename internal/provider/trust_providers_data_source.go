@@ -10,6 +10,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// trustProviderTypes maps the Aembit Trust Provider types to the matching trust provider attribute.
+var trustProviderTypes = map[string]string{
+	"AWSECSRole":               "aws_ecs_role",
+	"AWSMetadataService":       "aws_metadata",
+	"AWSRole":                  "aws_role",
+	"AzureMetadataService":     "azure_metadata",
+	"GcpIdentityToken":         "gcp_identity",
+	"GitHubIdentityToken":      "github_action",
+	"GitLabIdentityToken":      "gitlab_job",
+	"Kerberos":                 "kerberos",
+	"KubernetesServiceAccount": "kubernetes_service_account",
+	"OidcIdToken":              "oidc_id_token",
+	"TerraformIdentityToken":   "terraform_workspace",
+}
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ datasource.DataSource              = &trustProvidersDataSource{}
@@ -23,7 +38,8 @@ func NewTrustProvidersDataSource() datasource.DataSource {
 
 // trustProvidersDataSource is the data source implementation.
 type trustProvidersDataSource struct {
-	client *aembit.CloudClient
+	client *CloudClient
+	cache  *readCache
 }
 
 // Configure adds the provider configured client to the data source.
@@ -32,17 +48,18 @@ func (d *trustProvidersDataSource) Configure(_ context.Context, req datasource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*aembit.CloudClient)
+	data, ok := req.ProviderData.(*aembitProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *aembit.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.cache = data.cache
 }
 
 // Metadata returns the data source type name.
@@ -81,12 +98,20 @@ func (d *trustProvidersDataSource) Schema(_ context.Context, _ datasource.Schema
 							ElementType: types.StringType,
 							Computed:    true,
 						},
+						"client_id_type": schema.StringAttribute{
+							Description: "Identity type of the Aembit Client IDs attested by the Trust Provider, if it can be used for the provider `client_id`.",
+							Computed:    true,
+						},
 						"azure_metadata": schema.SingleNestedAttribute{
 							Description: "Azure Metadata type Trust Provider configuration.",
 							Computed:    true,
 							Attributes: map[string]schema.Attribute{
-								"sku":   schema.StringAttribute{Computed: true},
-								"vm_id": schema.StringAttribute{Computed: true},
+								"match_rules":      trustProviderMatchRulesDataSourceAttribute(),
+								"skus":             schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"vm_ids":           schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"subscription_ids": schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"sku":              schema.StringAttribute{Computed: true},
+								"vm_id":            schema.StringAttribute{Computed: true},
 								"subscription_id": schema.StringAttribute{
 									Computed: true,
 								},
@@ -96,6 +121,11 @@ func (d *trustProvidersDataSource) Schema(_ context.Context, _ datasource.Schema
 							Description: "AWS ECS Role type Trust Provider configuration.",
 							Computed:    true,
 							Attributes: map[string]schema.Attribute{
+								"match_rules":   trustProviderMatchRulesDataSourceAttribute(),
+								"account_ids":   schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"assumed_roles": schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"role_arns":     schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"usernames":     schema.SetAttribute{ElementType: types.StringType, Computed: true},
 								"account_id": schema.StringAttribute{
 									Description: "The ID of the AWS account that is hosting the ECS Task.",
 									Computed:    true,
@@ -118,10 +148,25 @@ func (d *trustProvidersDataSource) Schema(_ context.Context, _ datasource.Schema
 							Description: "AWS Metadata type Trust Provider configuration.",
 							Computed:    true,
 							Attributes: map[string]schema.Attribute{
+								"match_rules":        trustProviderMatchRulesDataSourceAttribute(),
+								"account_ids":        schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"architectures":      schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"availability_zones": schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"image_ids":          schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"instance_ids":       schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"instance_types":     schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"kernel_ids":         schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"pending_times":      schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"private_ips":        schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"ramdisk_ids":        schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"regions":            schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"versions":           schema.SetAttribute{ElementType: types.StringType, Computed: true},
 								"certificate": schema.StringAttribute{
+									CustomType:  pemType{},
 									Computed:    true,
 									Description: "PEM Certificate to be used for Signature verification",
 								},
+								"certificate_region":        schema.StringAttribute{Computed: true},
 								"account_id":                schema.StringAttribute{Computed: true},
 								"architecture":              schema.StringAttribute{Computed: true},
 								"availability_zone":         schema.StringAttribute{Computed: true},
@@ -138,10 +183,39 @@ func (d *trustProvidersDataSource) Schema(_ context.Context, _ datasource.Schema
 								"version":                   schema.StringAttribute{Computed: true},
 							},
 						},
+						"aws_role": schema.SingleNestedAttribute{
+							Description: "AWS Role type Trust Provider configuration.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"match_rules":   trustProviderMatchRulesDataSourceAttribute(),
+								"account_ids":   schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"assumed_roles": schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"role_arns":     schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"usernames":     schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"account_id": schema.StringAttribute{
+									Description: "The ID of the AWS account of the calling AWS IAM Role.",
+									Computed:    true,
+								},
+								"assumed_role": schema.StringAttribute{
+									Description: "The Name of the AWS IAM Role which is assumed by the caller.",
+									Computed:    true,
+								},
+								"role_arn": schema.StringAttribute{
+									Description: "The ARN of the AWS IAM Role which is assumed by the caller.",
+									Computed:    true,
+								},
+								"username": schema.StringAttribute{
+									Description: "The UserID of the AWS IAM Account of the caller (not commonly used).",
+									Computed:    true,
+								},
+							},
+						},
 						"gcp_identity": schema.SingleNestedAttribute{
 							Description: "GCP Identity type Trust Provider configuration.",
 							Computed:    true,
 							Attributes: map[string]schema.Attribute{
+								"match_rules": trustProviderMatchRulesDataSourceAttribute(),
+								"emails":      schema.SetAttribute{ElementType: types.StringType, Computed: true},
 								"email": schema.StringAttribute{
 									Description: "The Email of the GCP Service Account used by the associated GCP resource.",
 									Computed:    true,
@@ -152,6 +226,14 @@ func (d *trustProvidersDataSource) Schema(_ context.Context, _ datasource.Schema
 							Description: "GitHub Action type Trust Provider configuration.",
 							Computed:    true,
 							Attributes: map[string]schema.Attribute{
+								"match_rules":       trustProviderMatchRulesDataSourceAttribute(),
+								"actors":            schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"repositories":      schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"workflows":         schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"refs":              schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"environments":      schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"repository_owners": schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"job_workflow_refs": schema.SetAttribute{ElementType: types.StringType, Computed: true},
 								"actor": schema.StringAttribute{
 									Description: "The GitHub Actor which initiated the GitHub Action.",
 									Computed:    true,
@@ -164,12 +246,67 @@ func (d *trustProvidersDataSource) Schema(_ context.Context, _ datasource.Schema
 									Description: "The GitHub Workflow execution associated with the GitHub Action ID Token.",
 									Computed:    true,
 								},
+								"ref": schema.StringAttribute{
+									Description: "The Git Ref (`ref` claim) of the GitHub Action ID Token.",
+									Computed:    true,
+								},
+								"environment": schema.StringAttribute{
+									Description: "The GitHub Environment (`environment` claim) of the job associated with the GitHub Action ID Token.",
+									Computed:    true,
+								},
+								"repository_owner": schema.StringAttribute{
+									Description: "The Owner (`repository_owner` claim) of the repository associated with the GitHub Action ID Token.",
+									Computed:    true,
+								},
+								"job_workflow_ref": schema.StringAttribute{
+									Description: "The Reusable Workflow Ref (`job_workflow_ref` claim) of the job associated with the GitHub Action ID Token.",
+									Computed:    true,
+								},
+								"issuer": schema.StringAttribute{
+									Description: "The Issuer of the GitHub Action ID Tokens.",
+									Computed:    true,
+								},
+							},
+						},
+						"gitlab_job": schema.SingleNestedAttribute{
+							Description: "GitLab Job type Trust Provider configuration.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"match_rules":     trustProviderMatchRulesDataSourceAttribute(),
+								"namespace_paths": schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"project_paths":   schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"ref_paths":       schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"user_logins":     schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"oidc_endpoint": schema.StringAttribute{
+									Description: "The GitLab instance which issues the GitLab Job ID Tokens.",
+									Computed:    true,
+								},
+								"namespace_path": schema.StringAttribute{
+									Description: "The Namespace Path (`namespace_path` claim) of the GitLab Job ID Token.",
+									Computed:    true,
+								},
+								"project_path": schema.StringAttribute{
+									Description: "The Project Path (`project_path` claim) of the GitLab Job ID Token.",
+									Computed:    true,
+								},
+								"ref_path": schema.StringAttribute{
+									Description: "The Ref Path (`ref_path` claim) of the GitLab Job ID Token.",
+									Computed:    true,
+								},
+								"user_login": schema.StringAttribute{
+									Description: "The User Login (`user_login` claim) of the GitLab user which started the GitLab Job.",
+									Computed:    true,
+								},
 							},
 						},
 						"kerberos": schema.SingleNestedAttribute{
 							Description: "Kerberos type Trust Provider configuration.",
 							Computed:    true,
 							Attributes: map[string]schema.Attribute{
+								"match_rules": trustProviderMatchRulesDataSourceAttribute(),
+								"principals":  schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"realms":      schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"source_ips":  schema.SetAttribute{ElementType: types.StringType, Computed: true},
 								"agent_controller_ids": schema.SetAttribute{
 									Computed:    true,
 									ElementType: types.StringType,
@@ -183,6 +320,12 @@ func (d *trustProvidersDataSource) Schema(_ context.Context, _ datasource.Schema
 							Description: "Kubernetes Service Account type Trust Provider configuration.",
 							Computed:    true,
 							Attributes: map[string]schema.Attribute{
+								"match_rules":           trustProviderMatchRulesDataSourceAttribute(),
+								"issuers":               schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"namespaces":            schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"pod_names":             schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"service_account_names": schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"subjects":              schema.SetAttribute{ElementType: types.StringType, Computed: true},
 								"issuer": schema.StringAttribute{
 									Description: "The Issuer (`iss` claim) of the Kubernetes Service Account Token.",
 									Computed:    true,
@@ -208,15 +351,63 @@ func (d *trustProvidersDataSource) Schema(_ context.Context, _ datasource.Schema
 									Computed:    true,
 								},
 								"public_key": schema.StringAttribute{
+									CustomType:  pemType{},
 									Description: "The Public Key that can be used to verify the signature of the Kubernetes Service Account Token.",
 									Computed:    true,
 								},
+								"jwks": schema.StringAttribute{
+									Description: "The JSON Web Key Set of the Kubernetes cluster. Trust providers read from Aembit expose its keys as `public_key` instead.",
+									Computed:    true,
+								},
+							},
+						},
+						"oidc_id_token": schema.SingleNestedAttribute{
+							Description: "OIDC ID Token type Trust Provider configuration.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"match_rules": trustProviderMatchRulesDataSourceAttribute(),
+								"audiences":   schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"subjects":    schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"issuer": schema.StringAttribute{
+									Description: "The Issuer (`iss` claim) of the OIDC ID Token.",
+									Computed:    true,
+								},
+								"jwks_url": schema.StringAttribute{
+									Description: "The URL of the JSON Web Key Set which is used to verify the signature of the OIDC ID Token.",
+									Computed:    true,
+								},
+								"public_key": schema.StringAttribute{
+									CustomType:  pemType{},
+									Description: "The Public Key which is used to verify the signature of the OIDC ID Token.",
+									Computed:    true,
+								},
+								"audience": schema.StringAttribute{
+									Description: "The Audience (`aud` claim) of the OIDC ID Token.",
+									Computed:    true,
+								},
+								"subject": schema.StringAttribute{
+									Description: "The Subject (`sub` claim) of the OIDC ID Token.",
+									Computed:    true,
+								},
+								"claims": schema.MapAttribute{
+									Description: "Additional claims of the OIDC ID Token, mapped to the value they must have.",
+									ElementType: types.StringType,
+									Computed:    true,
+								},
 							},
 						},
 						"terraform_workspace": schema.SingleNestedAttribute{
 							Description: "Terraform Workspace type Trust Provider configuration.",
 							Computed:    true,
 							Attributes: map[string]schema.Attribute{
+								"match_rules":        trustProviderMatchRulesDataSourceAttribute(),
+								"organization_ids":   schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"project_ids":        schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"workspace_ids":      schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"organization_names": schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"project_names":      schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"workspace_names":    schema.SetAttribute{ElementType: types.StringType, Computed: true},
+								"run_phases":         schema.SetAttribute{ElementType: types.StringType, Computed: true},
 								"organization_id": schema.StringAttribute{
 									Description: "The Organization ID of the calling Terraform Workspace.",
 									Computed:    true,
@@ -229,6 +420,22 @@ func (d *trustProvidersDataSource) Schema(_ context.Context, _ datasource.Schema
 									Description: "The Workspace ID of the calling Terraform Workspace.",
 									Computed:    true,
 								},
+								"organization_name": schema.StringAttribute{
+									Description: "The Organization Name of the calling Terraform Workspace.",
+									Computed:    true,
+								},
+								"project_name": schema.StringAttribute{
+									Description: "The Project Name of the calling Terraform Workspace.",
+									Computed:    true,
+								},
+								"workspace_name": schema.StringAttribute{
+									Description: "The Workspace Name of the calling Terraform Workspace.",
+									Computed:    true,
+								},
+								"run_phase": schema.StringAttribute{
+									Description: "The Run Phase of the calling Terraform run, either `plan` or `apply`.",
+									Computed:    true,
+								},
 							},
 						},
 					},
@@ -236,13 +443,27 @@ func (d *trustProvidersDataSource) Schema(_ context.Context, _ datasource.Schema
 			},
 		},
 	}
+
+	addEntityFilterAttributes(resp.Schema.Attributes, "trust providers")
+	resp.Schema.Attributes["type"] = entityTypeFilterAttribute(trustProviderTypes, "trust providers", "kubernetes_service_account")
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *trustProvidersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state trustProvidersDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := newEntityFilter(ctx, state.NameRegex, state.Tags, state.IsActive)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	trustProviders, err := d.client.GetTrustProviders(nil)
+	trustProviders, err := cachedList(d.cache, "trust_provider", d.client.GetTrustProviders, func(e aembit.TrustProviderDTO) aembit.EntityDTO { return e.EntityDTO })
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Aembit Trust Providers",
@@ -253,14 +474,31 @@ func (d *trustProvidersDataSource) Read(ctx context.Context, req datasource.Read
 
 	// Map response body to model
 	for _, trustProvider := range trustProviders {
-		trustProviderState := convertTrustProviderDTOToModel(ctx, trustProvider)
+		if !filter.matches(trustProvider.EntityDTO) || (len(state.Type.ValueString()) > 0 && trustProviderTypes[trustProvider.Provider] != state.Type.ValueString()) {
+			continue
+		}
+		trustProviderState := convertTrustProviderDTOToModel(ctx, trustProvider, trustProviderResourceModel{})
 		state.TrustProviders = append(state.TrustProviders, trustProviderState)
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
+
+// trustProviderMatchRulesDataSourceAttribute defines the match rules which are not covered by the typed attributes of a trust provider.
+func trustProviderMatchRulesDataSourceAttribute() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Description: "Match rules for match attributes which are not available as attributes of the trust provider type.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"attribute": schema.StringAttribute{Computed: true},
+				"value":     schema.StringAttribute{Computed: true},
+			},
+		},
+	}
+}
@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// workloadCertificateResourceModel maps the resource schema.
+type workloadCertificateResourceModel struct {
+	ID                        types.String `tfsdk:"id"`
+	TargetHost                types.String `tfsdk:"target_host"`
+	TargetPort                types.Int64  `tfsdk:"target_port"`
+	TransportProtocol         types.String `tfsdk:"transport_protocol"`
+	CertificateSigningRequest types.String `tfsdk:"certificate_signing_request"`
+	CommonName                types.String `tfsdk:"common_name"`
+	PrivateKey                types.String `tfsdk:"private_key"`
+	CertificateChain          types.String `tfsdk:"certificate_chain"`
+	NotBefore                 types.String `tfsdk:"not_before"`
+	NotAfter                  types.String `tfsdk:"not_after"`
+	EarlyRenewalHours         types.Int64  `tfsdk:"early_renewal_hours"`
+}
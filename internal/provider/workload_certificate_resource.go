@@ -0,0 +1,363 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &workloadCertificateResource{}
+	_ resource.ResourceWithConfigure        = &workloadCertificateResource{}
+	_ resource.ResourceWithConfigValidators = &workloadCertificateResource{}
+	_ resource.ResourceWithModifyPlan       = &workloadCertificateResource{}
+)
+
+// NewWorkloadCertificateResource is a helper function to simplify the provider implementation.
+func NewWorkloadCertificateResource() resource.Resource {
+	return &workloadCertificateResource{}
+}
+
+// workloadCertificateResource is the resource implementation.
+type workloadCertificateResource struct {
+	edge *aembitEdgeClient
+}
+
+// Configure adds the provider configured Edge client to the resource.
+func (r *workloadCertificateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*aembitProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *aembitProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.edge = data.edge
+}
+
+// Metadata returns the resource type name.
+func (r *workloadCertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workload_certificate"
+}
+
+// Schema defines the schema for the resource.
+func (r *workloadCertificateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Requests a certificate from Aembit Edge for terminating TLS connections to a Server Workload, using the Trust Provider attestation of the provider `client_id`. " +
+			"The certificate is replaced when it is within `early_renewal_hours` of expiry.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Serial number of the issued certificate.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"target_host": schema.StringAttribute{
+				Description: "Hostname of the Server Workload.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_port": schema.Int64Attribute{
+				Description: "Port of the Server Workload.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"transport_protocol": schema.StringAttribute{
+				Description: "Transport Protocol of the Server Workload. Defaults to `TCP`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("TCP"),
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"TCP", "UDP"}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_signing_request": schema.StringAttribute{
+				Description: "PEM encoded Certificate Signing Request. If not provided, an ECDSA P-256 key pair and CSR are generated.",
+				Optional:    true,
+				Validators: []validator.String{
+					pemCertificateRequestValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"common_name": schema.StringAttribute{
+				Description: "Subject Common Name of the generated CSR. Defaults to `target_host`.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				Description: "PEM encoded (PKCS#8) generated private key. Only set when `certificate_signing_request` is not provided.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certificate_chain": schema.StringAttribute{
+				Description: "PEM encoded certificate chain issued by Aembit.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"not_before": schema.StringAttribute{
+				Description: "Time (RFC3339) from which the certificate is valid.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"not_after": schema.StringAttribute{
+				Description: "Time (RFC3339) at which the certificate expires.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"early_renewal_hours": schema.Int64Attribute{
+				Description: "Number of hours before `not_after` at which the certificate is renewed. Defaults to 0 (renewal once expired).",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func (r *workloadCertificateResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("certificate_signing_request"),
+			path.MatchRoot("common_name"),
+		),
+	}
+}
+
+// ModifyPlan replaces the certificate when it is within the early renewal window of expiry.
+func (r *workloadCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to renew when creating or destroying the certificate
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan workloadCertificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notAfter, err := time.Parse(time.RFC3339, state.NotAfter.ValueString())
+	if err != nil || !isCertificateDueForRenewal(notAfter, plan.EarlyRenewalHours.ValueInt64(), time.Now()) {
+		return
+	}
+
+	for _, attribute := range []string{"id", "private_key", "certificate_chain", "not_before", "not_after"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attribute), types.StringUnknown())...)
+	}
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("not_after"))
+}
+
+// Create requests a new certificate from Aembit Edge and sets the initial Terraform state.
+func (r *workloadCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan workloadCertificateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.edge == nil || len(r.edge.ClientID) == 0 {
+		resp.Diagnostics.AddError(
+			"Missing Aembit Client ID",
+			"The aembit_workload_certificate resource requires the provider to authenticate with a Trust Provider. "+
+				"Set the client_id value in the provider configuration or use the AEMBIT_CLIENT_ID environment variable.",
+		)
+		return
+	}
+
+	// Use the provided CSR, or generate a key pair and CSR for the target
+	csrPEM := plan.CertificateSigningRequest.ValueString()
+	plan.PrivateKey = types.StringNull()
+	if len(csrPEM) == 0 {
+		commonName := plan.TargetHost.ValueString()
+		if len(plan.CommonName.ValueString()) > 0 {
+			commonName = plan.CommonName.ValueString()
+		}
+
+		privateKeyPEM, generatedCSR, err := generateCertificateSigningRequest(commonName, plan.TargetHost.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating Workload Certificate",
+				"Could not generate Certificate Signing Request, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		csrPEM = generatedCSR
+		plan.PrivateKey = types.StringValue(privateKeyPEM)
+	}
+
+	// Request the certificate from Aembit Edge
+	target := ClientRequestNetwork{
+		TargetHost:        plan.TargetHost.ValueString(),
+		TargetPort:        int32(plan.TargetPort.ValueInt64()),
+		TransportProtocol: plan.TransportProtocol.ValueString(),
+	}
+	chain, err := getEdgeCertificate(ctx, target, csrPEM, r.edge.ClientID, r.edge.StackDomain)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Workload Certificate",
+			"Could not request Workload Certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	certificate, err := parseCertificateChain(chain)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Workload Certificate",
+			"Could not parse issued certificate chain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(certificate.SerialNumber.Text(16))
+	plan.CertificateChain = types.StringValue(chain)
+	plan.NotBefore = types.StringValue(certificate.NotBefore.UTC().Format(time.RFC3339))
+	plan.NotAfter = types.StringValue(certificate.NotAfter.UTC().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state. The certificate is only held in state, so there is nothing to refresh.
+func (r *workloadCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state workloadCertificateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the renewal window in the Terraform state. All other changes replace the certificate.
+func (r *workloadCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan workloadCertificateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the certificate from the Terraform state. Aembit certificates expire and are not revoked.
+func (r *workloadCertificateResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// generateCertificateSigningRequest generates an ECDSA P-256 key pair, returning the PEM encoded private key and CSR.
+func generateCertificateSigningRequest(commonName, targetHost string) (string, string, error) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	template := x509.CertificateRequest{Subject: pkix.Name{CommonName: commonName}}
+	if ip := net.ParseIP(targetHost); ip != nil {
+		template.IPAddresses = []net.IP{ip}
+	} else {
+		template.DNSNames = []string{targetHost}
+	}
+
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &template, privateKey)
+	if err != nil {
+		return "", "", err
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+	return string(keyPEM), string(csrPEM), nil
+}
+
+// parseCertificateChain returns the leaf (first) certificate of a PEM encoded certificate chain.
+func parseCertificateChain(chain string) (*x509.Certificate, error) {
+	rest := []byte(chain)
+	for {
+		var block *pem.Block
+		if block, rest = pem.Decode(rest); block == nil {
+			return nil, fmt.Errorf("no certificate found in chain")
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+// isCertificateDueForRenewal returns true once now is within earlyRenewalHours of notAfter.
+func isCertificateDueForRenewal(notAfter time.Time, earlyRenewalHours int64, now time.Time) bool {
+	return !now.Before(notAfter.Add(-time.Duration(earlyRenewalHours) * time.Hour))
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestGenerateCertificateSigningRequest(t *testing.T) {
+	keyPEM, csrPEM, err := generateCertificateSigningRequest("workload", "db.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if block, _ := pem.Decode([]byte(keyPEM)); block == nil || block.Type != "PRIVATE KEY" {
+		t.Errorf("expected a PEM encoded PRIVATE KEY, got %q", keyPEM)
+	}
+
+	block, _ := pem.Decode([]byte(csrPEM))
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		t.Fatalf("expected a PEM encoded CERTIFICATE REQUEST, got %q", csrPEM)
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		t.Fatalf("unexpected error parsing CSR: %v", err)
+	}
+	if csr.Subject.CommonName != "workload" || len(csr.DNSNames) != 1 || csr.DNSNames[0] != "db.example.com" {
+		t.Errorf("unexpected CSR subject %v and DNS names %v", csr.Subject, csr.DNSNames)
+	}
+
+	_, csrPEM, err = generateCertificateSigningRequest("workload", "10.0.0.1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	block, _ = pem.Decode([]byte(csrPEM))
+	if csr, err = x509.ParseCertificateRequest(block.Bytes); err != nil || len(csr.IPAddresses) != 1 {
+		t.Errorf("expected an IP SAN for an IP target, got %v (%v)", csr.IPAddresses, err)
+	}
+}
+
+func TestParseCertificateChain(t *testing.T) {
+	keyPEM, _, err := generateCertificateSigningRequest("workload", "db.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	block, _ := pem.Decode([]byte(keyPEM))
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	privateKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		t.Fatalf("expected an ECDSA private key, got %T", key)
+	}
+
+	notAfter := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(42),
+		Subject:      pkix.Name{CommonName: "workload"},
+		NotBefore:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	leaf := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	certificate, err := parseCertificateChain(leaf + leaf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !certificate.NotAfter.Equal(notAfter) || certificate.SerialNumber.Int64() != 42 {
+		t.Errorf("unexpected leaf certificate %v %v", certificate.NotAfter, certificate.SerialNumber)
+	}
+
+	if _, err = parseCertificateChain("not a certificate"); err == nil {
+		t.Error("expected an error for an invalid chain")
+	}
+}
+
+func TestIsCertificateDueForRenewal(t *testing.T) {
+	notAfter := time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		now               time.Time
+		earlyRenewalHours int64
+		want              bool
+	}{
+		{now: notAfter.Add(-48 * time.Hour), earlyRenewalHours: 0, want: false},
+		{now: notAfter, earlyRenewalHours: 0, want: true},
+		{now: notAfter.Add(-48 * time.Hour), earlyRenewalHours: 24, want: false},
+		{now: notAfter.Add(-12 * time.Hour), earlyRenewalHours: 24, want: true},
+	}
+	for _, c := range cases {
+		if got := isCertificateDueForRenewal(notAfter, c.earlyRenewalHours, c.now); got != c.want {
+			t.Errorf("isCertificateDueForRenewal(%v, %d, %v) = %v, want %v", notAfter, c.earlyRenewalHours, c.now, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package tenant
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"terraform-provider-aembit/internal/provider"
+)
+
+// command is a provider binary subcommand, returning the process exit code.
+type command func(args []string, version string) (int, error)
+
+var commands = map[string]command{
+	"snapshot": runSnapshot,
+	"diff":     runDiff,
+	"promote":  runPromote,
+}
+
+// IsCommand returns true if name is a supported provider binary subcommand.
+func IsCommand(name string) bool {
+	_, ok := commands[name]
+	return ok
+}
+
+// RunCommand executes the named subcommand and returns the process exit code.
+func RunCommand(name string, args []string, version string) (int, error) {
+	cmd, ok := commands[name]
+	if !ok {
+		return 1, fmt.Errorf("unknown command %q", name)
+	}
+	return cmd(args, version)
+}
+
+func runSnapshot(args []string, version string) (int, error) {
+	flags := flag.NewFlagSet("snapshot", flag.ContinueOnError)
+	out := flags.String("out", "-", "file to write the snapshot to, - for stdout")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: terraform-provider-aembit snapshot [-out file]")
+		fmt.Fprintln(flags.Output(), "\nWrites a normalized JSON snapshot of all entities in the Aembit Tenant.")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 1, err
+	}
+
+	client, err := provider.NewCloudClient(version)
+	if err != nil {
+		return 1, err
+	}
+	snapshot, err := TakeSnapshot(client)
+	if err != nil {
+		return 1, err
+	}
+	if err = WriteSnapshot(snapshot, *out); err != nil {
+		return 1, err
+	}
+	return 0, nil
+}
+
+func runDiff(args []string, version string) (int, error) {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	jsonOutput := flags.Bool("json", false, "write the report as JSON")
+	detailedExitCode := flags.Bool("detailed-exitcode", false, "exit with 2 when differences are found")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: terraform-provider-aembit diff [options] <before.json> [after.json]")
+		fmt.Fprintln(flags.Output(), "\nReports entities added, removed and changed between two snapshots.")
+		fmt.Fprintln(flags.Output(), "If only one snapshot is given, it is compared against the live Aembit Tenant.")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 1, err
+	}
+	if flags.NArg() < 1 || flags.NArg() > 2 {
+		flags.Usage()
+		return 1, fmt.Errorf("diff requires one or two snapshot files")
+	}
+
+	before, err := ReadSnapshot(flags.Arg(0))
+	if err != nil {
+		return 1, err
+	}
+
+	var after Snapshot
+	if flags.NArg() == 2 {
+		after, err = ReadSnapshot(flags.Arg(1))
+	} else {
+		client, clientErr := provider.NewCloudClient(version)
+		if clientErr != nil {
+			return 1, clientErr
+		}
+		after, err = TakeSnapshot(client)
+	}
+	if err != nil {
+		return 1, err
+	}
+
+	report := DiffSnapshots(before, after)
+	if *jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err = encoder.Encode(report); err != nil {
+			return 1, err
+		}
+	} else {
+		report.WriteText(os.Stdout)
+	}
+
+	if *detailedExitCode && report.HasChanges() {
+		return 2, nil
+	}
+	return 0, nil
+}
+
+func runPromote(args []string, version string) (int, error) {
+	flags := flag.NewFlagSet("promote", flag.ContinueOnError)
+	dryRun := flags.Bool("dry-run", false, "report the changes without applying them to the target Tenant")
+	secretsFile := flags.String("secrets", "", "JSON file supplying vaulted secrets by entity name")
+	jsonOutput := flags.Bool("json", false, "write the actions as JSON")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: terraform-provider-aembit promote [options] <source.json>")
+		fmt.Fprintln(flags.Output(), "\nCreates or updates the entities of a source Tenant snapshot in the target Aembit Tenant,")
+		fmt.Fprintln(flags.Output(), "matching entities by name and remapping references to the target External IDs.")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 1, err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 1, fmt.Errorf("promote requires a source snapshot file")
+	}
+
+	source, err := ReadSnapshot(flags.Arg(0))
+	if err != nil {
+		return 1, err
+	}
+	options := PromoteOptions{DryRun: *dryRun}
+	if len(*secretsFile) > 0 {
+		if options.Secrets, err = ReadPromoteSecrets(*secretsFile); err != nil {
+			return 1, err
+		}
+	}
+
+	client, err := provider.NewCloudClient(version)
+	if err != nil {
+		return 1, err
+	}
+	actions, promoteErr := Promote(client, source, options)
+
+	if *jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err = encoder.Encode(actions); err != nil {
+			return 1, err
+		}
+	} else {
+		WritePromoteActions(os.Stdout, actions, *dryRun)
+	}
+
+	if promoteErr != nil {
+		return 1, promoteErr
+	}
+	return 0, nil
+}
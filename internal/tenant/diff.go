@@ -0,0 +1,201 @@
+package tenant
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"aembit.io/aembit"
+)
+
+// EntityChange identifies a single entity which differs between two snapshots.
+type EntityChange struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Fields lists the top-level attributes which differ (only populated for changed entities).
+	Fields []string `json:"fields,omitempty"`
+}
+
+// TypeDiff holds the differences for a single entity type.
+type TypeDiff struct {
+	Type    string         `json:"type"`
+	Added   []EntityChange `json:"added"`
+	Removed []EntityChange `json:"removed"`
+	Changed []EntityChange `json:"changed"`
+}
+
+// DiffReport holds the differences between two snapshots, per entity type.
+type DiffReport struct {
+	Types []TypeDiff `json:"types"`
+}
+
+// HasChanges returns true if any entity was added, removed or changed.
+func (r DiffReport) HasChanges() bool {
+	for _, typeDiff := range r.Types {
+		if len(typeDiff.Added)+len(typeDiff.Removed)+len(typeDiff.Changed) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffSnapshots compares two snapshots, matching entities by External ID.
+func DiffSnapshots(before, after Snapshot) DiffReport {
+	var report DiffReport
+
+	report.Types = append(report.Types,
+		diffEntities("agent_controller", before.AgentControllers, after.AgentControllers, func(e aembit.AgentControllerDTO) aembit.EntityDTO { return e.EntityDTO }),
+		diffEntities("trust_provider", before.TrustProviders, after.TrustProviders, func(e aembit.TrustProviderDTO) aembit.EntityDTO { return e.EntityDTO }),
+		diffEntities("client_workload", before.ClientWorkloads, after.ClientWorkloads, func(e aembit.ClientWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO }),
+		diffEntities("server_workload", before.ServerWorkloads, after.ServerWorkloads, func(e aembit.ServerWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO }),
+		diffEntities("credential_provider", before.CredentialProviders, after.CredentialProviders, func(e aembit.CredentialProviderDTO) aembit.EntityDTO { return e.EntityDTO }),
+		diffEntities("integration", before.Integrations, after.Integrations, func(e aembit.IntegrationDTO) aembit.EntityDTO { return e.EntityDTO }),
+		diffEntities("access_condition", before.AccessConditions, after.AccessConditions, func(e aembit.AccessConditionDTO) aembit.EntityDTO { return e.EntityDTO }),
+		diffEntities("access_policy", before.AccessPolicies, after.AccessPolicies, func(e aembit.PolicyExternalDTO) aembit.EntityDTO { return e.EntityDTO }),
+		diffEntities("role", before.Roles, after.Roles, func(e aembit.EntityDTO) aembit.EntityDTO { return e }),
+	)
+	return report
+}
+
+// WriteText writes a human readable summary of the report.
+func (r DiffReport) WriteText(w io.Writer) {
+	if !r.HasChanges() {
+		fmt.Fprintln(w, "No differences found.")
+		return
+	}
+
+	for _, typeDiff := range r.Types {
+		for _, entity := range typeDiff.Added {
+			fmt.Fprintf(w, "+ %s %s (%s)\n", typeDiff.Type, entity.Name, entity.ID)
+		}
+		for _, entity := range typeDiff.Removed {
+			fmt.Fprintf(w, "- %s %s (%s)\n", typeDiff.Type, entity.Name, entity.ID)
+		}
+		for _, entity := range typeDiff.Changed {
+			fmt.Fprintf(w, "~ %s %s (%s): %v\n", typeDiff.Type, entity.Name, entity.ID, entity.Fields)
+		}
+	}
+}
+
+func diffEntities[T any](entityType string, before, after []T, entity func(T) aembit.EntityDTO) TypeDiff {
+	typeDiff := TypeDiff{
+		Type:    entityType,
+		Added:   []EntityChange{},
+		Removed: []EntityChange{},
+		Changed: []EntityChange{},
+	}
+
+	beforeByID := make(map[string]T, len(before))
+	for _, item := range before {
+		beforeByID[entity(item).ExternalID] = item
+	}
+	afterByID := make(map[string]T, len(after))
+	for _, item := range after {
+		afterByID[entity(item).ExternalID] = item
+	}
+
+	for id, item := range afterByID {
+		previous, ok := beforeByID[id]
+		if !ok {
+			typeDiff.Added = append(typeDiff.Added, EntityChange{ID: id, Name: entity(item).Name})
+			continue
+		}
+		if fields := changedFields(previous, item); len(fields) > 0 {
+			typeDiff.Changed = append(typeDiff.Changed, EntityChange{ID: id, Name: entity(item).Name, Fields: fields})
+		}
+	}
+	for id, item := range beforeByID {
+		if _, ok := afterByID[id]; !ok {
+			typeDiff.Removed = append(typeDiff.Removed, EntityChange{ID: id, Name: entity(item).Name})
+		}
+	}
+
+	sortChanges(typeDiff.Added)
+	sortChanges(typeDiff.Removed)
+	sortChanges(typeDiff.Changed)
+	return typeDiff
+}
+
+// serverManagedFields are the top-level JSON fields which Aembit sets, and which a snapshot therefore does not own.
+// They are not compared by diff or promote:
+//   - the identifiers, which differ between Tenants
+//   - the audit fields, which record who last saved the entity
+//   - the fields an Agent Controller reports when it checks in: the version of the running Agent Controller and its
+//     health. Comparing them would report every running Agent Controller as changed between two snapshots.
+var serverManagedFields = map[string]bool{
+	"id":                     true,
+	"externalId":             true,
+	"createdAt":              true,
+	"createdBy":              true,
+	"modifiedAt":             true,
+	"modifiedBy":             true,
+	"version":                true,
+	"isHealthy":              true,
+	"lastReportedHealthTime": true,
+}
+
+// changedFields compares the JSON representation of two entities and returns the names of the top-level fields which
+// differ. Only the fields owned by the snapshot are compared, see ownedFields.
+func changedFields(before, after interface{}) []string {
+	beforeFields := ownedFields(before)
+	afterFields := ownedFields(after)
+
+	var fields []string
+	for name, value := range afterFields {
+		if !reflect.DeepEqual(beforeFields[name], value) {
+			fields = append(fields, name)
+		}
+	}
+	for name := range beforeFields {
+		if _, ok := afterFields[name]; !ok {
+			fields = append(fields, name)
+		}
+	}
+
+	sort.Strings(fields)
+	return fields
+}
+
+// ownedFields returns the top-level JSON fields of the entity, without the serverManagedFields. Empty fields are left
+// out, as Aembit returns an omitted collection or string either as null or empty.
+func ownedFields(entity interface{}) map[string]interface{} {
+	fields := make(map[string]interface{})
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return fields
+	}
+	_ = json.Unmarshal(data, &fields)
+
+	for name, value := range fields {
+		if serverManagedFields[name] || isEmptyField(value) {
+			delete(fields, name)
+		}
+	}
+	return fields
+}
+
+// isEmptyField returns true for a null value, and for an empty string, list or object.
+func isEmptyField(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func sortChanges(changes []EntityChange) {
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Name != changes[j].Name {
+			return changes[i].Name < changes[j].Name
+		}
+		return changes[i].ID < changes[j].ID
+	})
+}
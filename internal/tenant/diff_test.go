@@ -0,0 +1,148 @@
+package tenant
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"aembit.io/aembit"
+)
+
+func TestSnapshotNormalize(t *testing.T) {
+	first := Snapshot{
+		TrustProviders: []aembit.TrustProviderDTO{
+			{
+				EntityDTO: aembit.EntityDTO{ExternalID: "b", Tags: []aembit.TagDTO{{Key: "z", Value: "1"}, {Key: "a", Value: "2"}}},
+				MatchRules: []aembit.TrustProviderMatchRuleDTO{
+					{Attribute: "GithubRepository", Value: "org/repo"},
+					{Attribute: "GithubActor", Value: "octocat"},
+				},
+			},
+			{EntityDTO: aembit.EntityDTO{ExternalID: "a"}},
+		},
+		CredentialProviders: []aembit.CredentialProviderDTO{
+			{EntityDTO: aembit.EntityDTO{ExternalID: "c"}, ProviderDetail: `{"roleId":"r","audience":"a"}`},
+		},
+	}
+	second := Snapshot{
+		TrustProviders: []aembit.TrustProviderDTO{
+			{EntityDTO: aembit.EntityDTO{ExternalID: "a"}},
+			{
+				EntityDTO: aembit.EntityDTO{ExternalID: "b", Tags: []aembit.TagDTO{{Key: "a", Value: "2"}, {Key: "z", Value: "1"}}},
+				MatchRules: []aembit.TrustProviderMatchRuleDTO{
+					{Attribute: "GithubActor", Value: "octocat"},
+					{Attribute: "GithubRepository", Value: "org/repo"},
+				},
+			},
+		},
+		CredentialProviders: []aembit.CredentialProviderDTO{
+			{EntityDTO: aembit.EntityDTO{ExternalID: "c"}, ProviderDetail: `{"audience": "a", "roleId": "r"}`},
+		},
+	}
+
+	first.Normalize()
+	second.Normalize()
+
+	firstJSON, _ := json.Marshal(first)
+	secondJSON, _ := json.Marshal(second)
+	if string(firstJSON) != string(secondJSON) {
+		t.Fatalf("expected normalized snapshots to be identical:\n%s\n%s", firstJSON, secondJSON)
+	}
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	before := Snapshot{
+		ServerWorkloads: []aembit.ServerWorkloadExternalDTO{
+			{EntityDTO: aembit.EntityDTO{ExternalID: "1", Name: "unchanged"}},
+			{EntityDTO: aembit.EntityDTO{ExternalID: "2", Name: "changed"}},
+			{EntityDTO: aembit.EntityDTO{ExternalID: "3", Name: "removed"}},
+		},
+	}
+	after := Snapshot{
+		ServerWorkloads: []aembit.ServerWorkloadExternalDTO{
+			{EntityDTO: aembit.EntityDTO{ExternalID: "1", Name: "unchanged"}},
+			{EntityDTO: aembit.EntityDTO{ExternalID: "2", Name: "changed", Description: "modified in the UI"}},
+			{EntityDTO: aembit.EntityDTO{ExternalID: "4", Name: "added"}},
+		},
+	}
+
+	report := DiffSnapshots(before, after)
+	if !report.HasChanges() {
+		t.Fatal("expected report to have changes")
+	}
+
+	var serverWorkloads TypeDiff
+	for _, typeDiff := range report.Types {
+		if typeDiff.Type == "server_workload" {
+			serverWorkloads = typeDiff
+		} else if len(typeDiff.Added)+len(typeDiff.Removed)+len(typeDiff.Changed) > 0 {
+			t.Errorf("unexpected changes for %s", typeDiff.Type)
+		}
+	}
+
+	if want := []EntityChange{{ID: "4", Name: "added"}}; !reflect.DeepEqual(serverWorkloads.Added, want) {
+		t.Errorf("added = %v, want %v", serverWorkloads.Added, want)
+	}
+	if want := []EntityChange{{ID: "3", Name: "removed"}}; !reflect.DeepEqual(serverWorkloads.Removed, want) {
+		t.Errorf("removed = %v, want %v", serverWorkloads.Removed, want)
+	}
+	if len(serverWorkloads.Changed) != 1 || serverWorkloads.Changed[0].ID != "2" {
+		t.Fatalf("changed = %v, want entity 2", serverWorkloads.Changed)
+	}
+	if len(serverWorkloads.Changed[0].Fields) == 0 {
+		t.Errorf("expected changed fields to be reported")
+	}
+}
+
+func TestDiffSnapshots_NoChanges(t *testing.T) {
+	snapshot := Snapshot{
+		ClientWorkloads: []aembit.ClientWorkloadExternalDTO{
+			{EntityDTO: aembit.EntityDTO{ExternalID: "1", Name: "client"}},
+		},
+	}
+
+	if report := DiffSnapshots(snapshot, snapshot); report.HasChanges() {
+		t.Errorf("expected no changes, got %v", report)
+	}
+}
+
+func TestDiffSnapshots_ServerManagedFields(t *testing.T) {
+	before := Snapshot{
+		AgentControllers: []aembit.AgentControllerDTO{
+			{
+				EntityDTO:              aembit.EntityDTO{ExternalID: "1", Name: "controller"},
+				TrustProviderID:        "trust-provider",
+				Version:                "1.20.1",
+				IsHealthy:              true,
+				LastReportedHealthTime: "2024-01-01T00:00:00Z",
+			},
+		},
+	}
+
+	// The Agent Controller was upgraded and checked in again, and Aembit returned its tags as an empty list.
+	after := Snapshot{
+		AgentControllers: []aembit.AgentControllerDTO{
+			{
+				EntityDTO:              aembit.EntityDTO{ExternalID: "1", Name: "controller", Tags: []aembit.TagDTO{}},
+				TrustProviderID:        "trust-provider",
+				Version:                "1.21.0",
+				IsHealthy:              false,
+				LastReportedHealthTime: "2024-01-02T00:00:00Z",
+			},
+		},
+	}
+	if report := DiffSnapshots(before, after); report.HasChanges() {
+		t.Errorf("expected the reported version and health to be ignored, got %v", report)
+	}
+
+	after.AgentControllers[0].TrustProviderID = "other-trust-provider"
+	report := DiffSnapshots(before, after)
+	for _, typeDiff := range report.Types {
+		if typeDiff.Type != "agent_controller" {
+			continue
+		}
+		if len(typeDiff.Changed) != 1 || !reflect.DeepEqual(typeDiff.Changed[0].Fields, []string{"trustProviderId"}) {
+			t.Errorf("expected only the trust provider to be changed, got %v", typeDiff.Changed)
+		}
+	}
+}
@@ -0,0 +1,680 @@
+package tenant
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"aembit.io/aembit"
+	"terraform-provider-aembit/internal/provider"
+)
+
+// PromoteSecrets supplies the vaulted secrets which Aembit does not return from the source Tenant,
+// keyed by entity name. Entities which require a secret are skipped unless it is supplied here.
+type PromoteSecrets struct {
+	// CredentialProviders maps a Credential Provider name to its API Key, OAuth Client Secret or Password.
+	CredentialProviders map[string]string `json:"credentialProviders"`
+	// Integrations maps an Integration name to its OAuth Client Secret.
+	Integrations map[string]string `json:"integrations"`
+}
+
+// PromoteOptions configures a promotion from a source Tenant snapshot into a target Tenant.
+type PromoteOptions struct {
+	DryRun  bool
+	Secrets PromoteSecrets
+}
+
+// PromoteAction records what was (or would be, in dry-run mode) done for a single source entity.
+type PromoteAction struct {
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	Action   string `json:"action"`
+	SourceID string `json:"sourceId"`
+	TargetID string `json:"targetId,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// Promote actions.
+const (
+	actionCreate    = "create"
+	actionUpdate    = "update"
+	actionUnchanged = "unchanged"
+	actionSkip      = "skip"
+)
+
+// ReadPromoteSecrets loads a PromoteSecrets JSON document.
+func ReadPromoteSecrets(fileName string) (PromoteSecrets, error) {
+	var secrets PromoteSecrets
+
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return secrets, err
+	}
+	err = json.Unmarshal(data, &secrets)
+	return secrets, err
+}
+
+// WritePromoteActions writes a human readable summary of the promotion.
+func WritePromoteActions(w io.Writer, actions []PromoteAction, dryRun bool) {
+	if dryRun {
+		fmt.Fprintln(w, "Dry run: no changes have been made to the target Tenant.")
+	}
+	for _, action := range actions {
+		line := fmt.Sprintf("%-9s %s %s", action.Action, action.Type, action.Name)
+		if len(action.Reason) > 0 {
+			line += " (" + action.Reason + ")"
+		}
+		fmt.Fprintln(w, line)
+	}
+}
+
+type promoter struct {
+	client  *provider.CloudClient
+	source  Snapshot
+	target  Snapshot
+	options PromoteOptions
+	// ids maps source External IDs to their target External IDs.
+	ids     map[string]string
+	actions []PromoteAction
+}
+
+// Promote recreates or updates the entities of the source snapshot in the Tenant of the client, matching entities by name.
+// References between entities (e.g. the workloads and providers of an Access Policy) are remapped to the target External IDs.
+func Promote(client *provider.CloudClient, source Snapshot, options PromoteOptions) ([]PromoteAction, error) {
+	target, err := TakeSnapshot(client)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &promoter{
+		client:  client,
+		source:  source,
+		target:  target,
+		options: options,
+		ids:     make(map[string]string),
+	}
+
+	// Kerberos Trust Providers reference Agent Controllers, while Agent Controllers may reference
+	// another Trust Provider for their own attestation, so Trust Providers are promoted in two passes.
+	steps := []func(Snapshot) error{
+		func(s Snapshot) error { return p.promoteTrustProviders(s, false) },
+		p.promoteAgentControllers,
+		func(s Snapshot) error { return p.promoteTrustProviders(s, true) },
+		p.promoteClientWorkloads,
+		p.promoteServerWorkloads,
+		p.promoteCredentialProviders,
+		p.promoteIntegrations,
+		p.promoteAccessConditions,
+		p.promoteAccessPolicies,
+	}
+	for _, step := range steps {
+		if err := step(source); err != nil {
+			return p.actions, err
+		}
+	}
+
+	return p.actions, nil
+}
+
+func (p *promoter) record(entityType, name, action, sourceID, targetID, reason string) {
+	if len(targetID) > 0 {
+		p.ids[sourceID] = targetID
+	}
+	p.actions = append(p.actions, PromoteAction{
+		Type:     entityType,
+		Name:     name,
+		Action:   action,
+		SourceID: sourceID,
+		TargetID: targetID,
+		Reason:   reason,
+	})
+}
+
+// remap returns the target External ID for a source External ID, or false if the source entity was not promoted.
+func (p *promoter) remap(sourceID string) (string, bool) {
+	targetID, ok := p.ids[sourceID]
+	return targetID, ok
+}
+
+// remapRole returns the External ID of the target Role with the same name as the source Role. Roles are not promoted,
+// so they must already exist in the target Tenant.
+func (p *promoter) remapRole(sourceID string) (string, error) {
+	sourceRole, ok := findByID(p.source.Roles, sourceID)
+	if !ok {
+		return "", fmt.Errorf("role %s is not in the source snapshot", sourceID)
+	}
+	targetRole, exists, err := findByName(p.target.Roles, sourceRole.Name, func(e aembit.EntityDTO) aembit.EntityDTO { return e })
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", fmt.Errorf("role %q does not exist in the target tenant", sourceRole.Name)
+	}
+	return targetRole.ExternalID, nil
+}
+
+// findByID returns the entity with the given External ID.
+func findByID(items []aembit.EntityDTO, id string) (aembit.EntityDTO, bool) {
+	for _, item := range items {
+		if item.ExternalID == id {
+			return item, true
+		}
+	}
+	return aembit.EntityDTO{}, false
+}
+
+// plannedID is used in place of a target External ID for entities which would be created in dry-run mode.
+func plannedID(name string) string {
+	return "(new) " + name
+}
+
+// findByName returns the target entity with the given name, or an error if the name is ambiguous in the target Tenant.
+func findByName[T any](items []T, name string, entity func(T) aembit.EntityDTO) (T, bool, error) {
+	var found T
+	var count int
+
+	for _, item := range items {
+		if entity(item).Name == name {
+			found = item
+			count++
+		}
+	}
+	if count > 1 {
+		return found, false, fmt.Errorf("%d entities named %q in target tenant", count, name)
+	}
+	return found, count == 1, nil
+}
+
+// apply creates or updates a single entity and records the result. The desired entity must already have
+// its references remapped; existing is the matching target entity, if any.
+func (p *promoter) apply(entityType, sourceID, name string, exists bool, targetID string, desired, existing interface{},
+	create func() (string, error), update func() error) error {
+	if !exists {
+		if p.options.DryRun {
+			p.record(entityType, name, actionCreate, sourceID, plannedID(name), "")
+			return nil
+		}
+		createdID, err := create()
+		if err != nil {
+			return fmt.Errorf("creating %s %q: %w", entityType, name, err)
+		}
+		p.record(entityType, name, actionCreate, sourceID, createdID, "")
+		return nil
+	}
+
+	fields := changedFields(existing, desired)
+	if len(fields) == 0 {
+		p.record(entityType, name, actionUnchanged, sourceID, targetID, "")
+		return nil
+	}
+	if !p.options.DryRun {
+		if err := update(); err != nil {
+			return fmt.Errorf("updating %s %q: %w", entityType, name, err)
+		}
+	}
+	p.record(entityType, name, actionUpdate, sourceID, targetID, strings.Join(fields, ", "))
+	return nil
+}
+
+func (p *promoter) promoteTrustProviders(source Snapshot, withAgentControllers bool) error {
+	for _, sourceTrust := range source.TrustProviders {
+		if (len(sourceTrust.AgentControllerIDs) > 0) != withAgentControllers {
+			continue
+		}
+
+		trust := sourceTrust
+		trust.AgentControllerIDs = make([]string, 0, len(sourceTrust.AgentControllerIDs))
+		missing := ""
+		for _, controllerID := range sourceTrust.AgentControllerIDs {
+			targetControllerID, ok := p.remap(controllerID)
+			if !ok {
+				missing = controllerID
+				break
+			}
+			trust.AgentControllerIDs = append(trust.AgentControllerIDs, targetControllerID)
+		}
+		if len(missing) > 0 {
+			p.record("trust_provider", trust.Name, actionSkip, sourceTrust.ExternalID, "", "agent controller "+missing+" was not promoted")
+			continue
+		}
+
+		existing, exists, err := findByName(p.target.TrustProviders, trust.Name, func(e aembit.TrustProviderDTO) aembit.EntityDTO { return e.EntityDTO })
+		if err != nil {
+			p.record("trust_provider", trust.Name, actionSkip, sourceTrust.ExternalID, "", err.Error())
+			continue
+		}
+		trust.ExternalID = existing.ExternalID
+
+		err = p.apply("trust_provider", sourceTrust.ExternalID, trust.Name, exists, existing.ExternalID, trust, existing,
+			func() (string, error) {
+				created, err := p.client.CreateTrustProvider(trust, nil)
+				if err != nil {
+					return "", err
+				}
+				return created.ExternalID, nil
+			},
+			func() error {
+				_, err := p.client.UpdateTrustProvider(trust, nil)
+				return err
+			})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *promoter) promoteAgentControllers(source Snapshot) error {
+	for _, sourceController := range source.AgentControllers {
+		controller := sourceController
+		if len(sourceController.TrustProviderID) > 0 {
+			trustProviderID, ok := p.remap(sourceController.TrustProviderID)
+			if !ok {
+				p.record("agent_controller", controller.Name, actionSkip, sourceController.ExternalID, "", "trust provider "+sourceController.TrustProviderID+" was not promoted")
+				continue
+			}
+			controller.TrustProviderID = trustProviderID
+		}
+
+		existing, exists, err := findByName(p.target.AgentControllers, controller.Name, func(e aembit.AgentControllerDTO) aembit.EntityDTO { return e.EntityDTO })
+		if err != nil {
+			p.record("agent_controller", controller.Name, actionSkip, sourceController.ExternalID, "", err.Error())
+			continue
+		}
+		controller.ExternalID = existing.ExternalID
+
+		err = p.apply("agent_controller", sourceController.ExternalID, controller.Name, exists, existing.ExternalID, controller, existing,
+			func() (string, error) {
+				created, err := p.client.CreateAgentController(controller, nil)
+				if err != nil {
+					return "", err
+				}
+				return created.ExternalID, nil
+			},
+			func() error {
+				_, err := p.client.UpdateAgentController(controller, nil)
+				return err
+			})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *promoter) promoteClientWorkloads(source Snapshot) error {
+	for _, sourceWorkload := range source.ClientWorkloads {
+		workload := sourceWorkload
+
+		existing, exists, err := findByName(p.target.ClientWorkloads, workload.Name, func(e aembit.ClientWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO })
+		if err != nil {
+			p.record("client_workload", workload.Name, actionSkip, sourceWorkload.ExternalID, "", err.Error())
+			continue
+		}
+		workload.ExternalID = existing.ExternalID
+
+		err = p.apply("client_workload", sourceWorkload.ExternalID, workload.Name, exists, existing.ExternalID, workload, existing,
+			func() (string, error) {
+				created, err := p.client.CreateClientWorkload(workload, nil)
+				if err != nil {
+					return "", err
+				}
+				return created.ExternalID, nil
+			},
+			func() error {
+				_, err := p.client.UpdateClientWorkload(workload, nil)
+				return err
+			})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *promoter) promoteServerWorkloads(source Snapshot) error {
+	for _, sourceWorkload := range source.ServerWorkloads {
+		workload := sourceWorkload
+
+		existing, exists, err := findByName(p.target.ServerWorkloads, workload.Name, func(e aembit.ServerWorkloadExternalDTO) aembit.EntityDTO { return e.EntityDTO })
+		if err != nil {
+			p.record("server_workload", workload.Name, actionSkip, sourceWorkload.ExternalID, "", err.Error())
+			continue
+		}
+		// The Service Endpoint identifiers belong to the Tenant, so take them from the target entity (if any).
+		workload.ExternalID = existing.ExternalID
+		workload.ServiceEndpoint.ExternalID = existing.ServiceEndpoint.ExternalID
+		workload.ServiceEndpoint.ID = existing.ServiceEndpoint.ID
+
+		err = p.apply("server_workload", sourceWorkload.ExternalID, workload.Name, exists, existing.ExternalID, workload, existing,
+			func() (string, error) {
+				created, err := p.client.CreateServerWorkload(workload, nil)
+				if err != nil {
+					return "", err
+				}
+				return created.ExternalID, nil
+			},
+			func() error {
+				_, err := p.client.UpdateServerWorkload(workload, nil)
+				return err
+			})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *promoter) promoteCredentialProviders(source Snapshot) error {
+	for _, sourceCredential := range source.CredentialProviders {
+		credential := sourceCredential
+
+		var err error
+		secret, hasSecret := p.options.Secrets.CredentialProviders[credential.Name]
+		if credential.ProviderDetail, err = p.credentialProviderDetail(sourceCredential, secret, hasSecret); err != nil {
+			p.record("credential_provider", credential.Name, actionSkip, sourceCredential.ExternalID, "", err.Error())
+			continue
+		}
+
+		existing, exists, err := findByName(p.target.CredentialProviders, credential.Name, func(e aembit.CredentialProviderDTO) aembit.EntityDTO { return e.EntityDTO })
+		if err != nil {
+			p.record("credential_provider", credential.Name, actionSkip, sourceCredential.ExternalID, "", err.Error())
+			continue
+		}
+		credential.ExternalID = existing.ExternalID
+
+		err = p.apply("credential_provider", sourceCredential.ExternalID, credential.Name, exists, existing.ExternalID, withoutSecrets(credential), withoutSecrets(existing),
+			func() (string, error) {
+				created, err := p.client.CreateCredentialProvider(credential, nil)
+				if err != nil {
+					return "", err
+				}
+				return created.ExternalID, nil
+			},
+			func() error {
+				_, err := p.client.UpdateCredentialProvider(credential, nil)
+				return err
+			})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// credentialProviderDetail rewrites the Credential Provider details for the target Tenant, injecting the vaulted secret
+// for those types which require one.
+func (p *promoter) credentialProviderDetail(dto aembit.CredentialProviderDTO, secret string, hasSecret bool) (string, error) {
+	var detail interface{}
+
+	switch dto.Type {
+	case "aembit-access-token":
+		var aembitToken aembit.CredentialAembitTokenDTO
+		if err := json.Unmarshal([]byte(dto.ProviderDetail), &aembitToken); err != nil {
+			return "", err
+		}
+		aembitToken.Audience = fmt.Sprintf("%s.api.%s", p.client.Tenant, p.client.StackDomain)
+		roleID, err := p.remapRole(aembitToken.RoleID)
+		if err != nil {
+			return "", err
+		}
+		aembitToken.RoleID = roleID
+		detail = aembitToken
+	case "apikey":
+		if !hasSecret {
+			return "", fmt.Errorf("vaulted api key was not supplied")
+		}
+		detail = aembit.CredentialAPIKeyDTO{APIKey: secret}
+	case "oauth-client-credential":
+		if !hasSecret {
+			return "", fmt.Errorf("vaulted client secret was not supplied")
+		}
+		var oauth aembit.CredentialOAuthClientCredentialDTO
+		if err := json.Unmarshal([]byte(dto.ProviderDetail), &oauth); err != nil {
+			return "", err
+		}
+		oauth.ClientSecret = secret
+		detail = oauth
+	case "username-password":
+		if !hasSecret {
+			return "", fmt.Errorf("vaulted password was not supplied")
+		}
+		var userPass aembit.CredentialUsernamePasswordDTO
+		if err := json.Unmarshal([]byte(dto.ProviderDetail), &userPass); err != nil {
+			return "", err
+		}
+		userPass.Password = secret
+		detail = userPass
+	case "vaultClientToken":
+		var vault aembit.CredentialVaultClientTokenDTO
+		if err := json.Unmarshal([]byte(dto.ProviderDetail), &vault); err != nil {
+			return "", err
+		}
+		if vault.JwtConfig != nil {
+			vault.JwtConfig.Issuer = fmt.Sprintf("https://%s.id.%s/", p.client.Tenant, p.client.StackDomain)
+		}
+		detail = vault
+	default:
+		return dto.ProviderDetail, nil
+	}
+
+	detailJSON, err := json.Marshal(detail)
+	if err != nil {
+		return "", err
+	}
+	return normalizeJSON(string(detailJSON)), nil
+}
+
+// secretFields are the Credential Provider detail fields which hold a vaulted secret.
+var secretFields = []string{"apiKey", "clientSecret", "password"}
+
+// withoutSecrets returns the Credential Provider without the secretFields of its details. Aembit never returns a vaulted
+// secret, so secrets are write-only: a supplied secret is sent when the entity is created or updated, but is not compared
+// with the target entity.
+func withoutSecrets(dto aembit.CredentialProviderDTO) aembit.CredentialProviderDTO {
+	var detail map[string]interface{}
+	if err := json.Unmarshal([]byte(dto.ProviderDetail), &detail); err != nil {
+		return dto
+	}
+	for _, field := range secretFields {
+		delete(detail, field)
+	}
+
+	detailJSON, err := json.Marshal(detail)
+	if err != nil {
+		return dto
+	}
+	dto.ProviderDetail = string(detailJSON)
+	return dto
+}
+
+func (p *promoter) promoteIntegrations(source Snapshot) error {
+	for _, sourceIntegration := range source.Integrations {
+		integration := sourceIntegration
+
+		secret, ok := p.options.Secrets.Integrations[integration.Name]
+		if !ok {
+			p.record("integration", integration.Name, actionSkip, sourceIntegration.ExternalID, "", "vaulted client secret was not supplied")
+			continue
+		}
+		integration.IntegrationJSON.ClientSecret = secret
+
+		existing, exists, err := findByName(p.target.Integrations, integration.Name, func(e aembit.IntegrationDTO) aembit.EntityDTO { return e.EntityDTO })
+		if err != nil {
+			p.record("integration", integration.Name, actionSkip, sourceIntegration.ExternalID, "", err.Error())
+			continue
+		}
+		integration.ExternalID = existing.ExternalID
+
+		// The vaulted client secret is write-only, see withoutSecrets.
+		desired, current := integration, existing
+		desired.IntegrationJSON.ClientSecret = ""
+		current.IntegrationJSON.ClientSecret = ""
+
+		err = p.apply("integration", sourceIntegration.ExternalID, integration.Name, exists, existing.ExternalID, desired, current,
+			func() (string, error) {
+				created, err := p.client.CreateIntegration(integration, nil)
+				if err != nil {
+					return "", err
+				}
+				return created.ExternalID, nil
+			},
+			func() error {
+				_, err := p.client.UpdateIntegration(integration, nil)
+				return err
+			})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *promoter) promoteAccessConditions(source Snapshot) error {
+	for _, sourceCondition := range source.AccessConditions {
+		condition := sourceCondition
+
+		// The Integration may be returned either by ID or as a nested entity.
+		integrationID := sourceCondition.IntegrationID
+		if len(integrationID) == 0 {
+			integrationID = sourceCondition.Integration.ExternalID
+		}
+		targetIntegrationID, ok := p.remap(integrationID)
+		if !ok {
+			p.record("access_condition", condition.Name, actionSkip, sourceCondition.ExternalID, "", "integration "+integrationID+" was not promoted")
+			continue
+		}
+		condition.IntegrationID = targetIntegrationID
+
+		existing, exists, err := findByName(p.target.AccessConditions, condition.Name, func(e aembit.AccessConditionDTO) aembit.EntityDTO { return e.EntityDTO })
+		if err != nil {
+			p.record("access_condition", condition.Name, actionSkip, sourceCondition.ExternalID, "", err.Error())
+			continue
+		}
+		condition.ExternalID = existing.ExternalID
+		condition.Integration = existing.Integration
+		if len(existing.IntegrationID) == 0 && existing.Integration.ExternalID == targetIntegrationID {
+			// Compare against the target entity in the same shape it was returned in.
+			condition.IntegrationID = ""
+		}
+
+		err = p.apply("access_condition", sourceCondition.ExternalID, condition.Name, exists, existing.ExternalID, condition, existing,
+			func() (string, error) {
+				created, err := p.client.CreateAccessCondition(condition, nil)
+				if err != nil {
+					return "", err
+				}
+				return created.ExternalID, nil
+			},
+			func() error {
+				condition.IntegrationID = targetIntegrationID
+				_, err := p.client.UpdateAccessCondition(condition, nil)
+				return err
+			})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *promoter) promoteAccessPolicies(source Snapshot) error {
+	for _, sourcePolicy := range source.AccessPolicies {
+		policy, name, err := p.remapPolicy(sourcePolicy)
+		if err != nil {
+			p.record("access_policy", name, actionSkip, sourcePolicy.ExternalID, "", err.Error())
+			continue
+		}
+
+		// Access Policies are unnamed, so they are matched on the Client and Server Workload pair instead.
+		var existing aembit.PolicyDTO
+		exists := false
+		for _, targetPolicy := range p.target.AccessPolicies {
+			if targetPolicy.ClientWorkload.ExternalID == policy.ClientWorkload && targetPolicy.ServerWorkload.ExternalID == policy.ServerWorkload {
+				existing = policyExternalToPolicy(targetPolicy)
+				exists = true
+				break
+			}
+		}
+		policy.ExternalID = existing.ExternalID
+		policy.Name = existing.Name
+		if !exists {
+			policy.Name = sourcePolicy.Name
+		}
+
+		err = p.apply("access_policy", sourcePolicy.ExternalID, name, exists, existing.ExternalID, policy, existing,
+			func() (string, error) {
+				created, err := p.client.CreateAccessPolicy(policy, nil)
+				if err != nil {
+					return "", err
+				}
+				return created.ExternalID, nil
+			},
+			func() error {
+				_, err := p.client.UpdateAccessPolicy(policy, nil)
+				return err
+			})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// remapPolicy converts a source Access Policy into a PolicyDTO referencing the target External IDs.
+func (p *promoter) remapPolicy(source aembit.PolicyExternalDTO) (aembit.PolicyDTO, string, error) {
+	name := fmt.Sprintf("%s -> %s", source.ClientWorkload.Name, source.ServerWorkload.Name)
+
+	var policy aembit.PolicyDTO
+	policy.EntityDTO = aembit.EntityDTO{
+		Name:     source.Name,
+		IsActive: source.IsActive,
+		Tags:     source.Tags,
+	}
+
+	var ok bool
+	if policy.ClientWorkload, ok = p.remap(source.ClientWorkload.ExternalID); !ok {
+		return policy, name, fmt.Errorf("client workload %s was not promoted", source.ClientWorkload.ExternalID)
+	}
+	if policy.ServerWorkload, ok = p.remap(source.ServerWorkload.ExternalID); !ok {
+		return policy, name, fmt.Errorf("server workload %s was not promoted", source.ServerWorkload.ExternalID)
+	}
+	if len(source.CredentialProvider.ExternalID) > 0 {
+		if policy.CredentialProvider, ok = p.remap(source.CredentialProvider.ExternalID); !ok {
+			return policy, name, fmt.Errorf("credential provider %s was not promoted", source.CredentialProvider.ExternalID)
+		}
+	}
+	policy.TrustProviders = make([]string, len(source.TrustProviders))
+	for i, trustProvider := range source.TrustProviders {
+		if policy.TrustProviders[i], ok = p.remap(trustProvider.ExternalID); !ok {
+			return policy, name, fmt.Errorf("trust provider %s was not promoted", trustProvider.ExternalID)
+		}
+	}
+	policy.AccessConditions = make([]string, len(source.AccessConditions))
+	for i, accessCondition := range source.AccessConditions {
+		if policy.AccessConditions[i], ok = p.remap(accessCondition.ExternalID); !ok {
+			return policy, name, fmt.Errorf("access condition %s was not promoted", accessCondition.ExternalID)
+		}
+	}
+
+	return policy, name, nil
+}
+
+func policyExternalToPolicy(dto aembit.PolicyExternalDTO) aembit.PolicyDTO {
+	var policy aembit.PolicyDTO
+	policy.EntityDTO = dto.EntityDTO
+	policy.ClientWorkload = dto.ClientWorkload.ExternalID
+	policy.ServerWorkload = dto.ServerWorkload.ExternalID
+	policy.CredentialProvider = dto.CredentialProvider.ExternalID
+	policy.TrustProviders = make([]string, len(dto.TrustProviders))
+	for i, trustProvider := range dto.TrustProviders {
+		policy.TrustProviders[i] = trustProvider.ExternalID
+	}
+	policy.AccessConditions = make([]string, len(dto.AccessConditions))
+	for i, accessCondition := range dto.AccessConditions {
+		policy.AccessConditions[i] = accessCondition.ExternalID
+	}
+	return policy
+}
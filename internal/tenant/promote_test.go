@@ -0,0 +1,191 @@
+package tenant
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"aembit.io/aembit"
+	"terraform-provider-aembit/internal/provider"
+)
+
+func TestPromoteRemapPolicy(t *testing.T) {
+	p := &promoter{ids: map[string]string{
+		"source-cw": "target-cw",
+		"source-sw": "target-sw",
+		"source-tp": "target-tp",
+		"source-ac": "target-ac",
+		"source-cp": "target-cp",
+	}}
+
+	policy, _, err := p.remapPolicy(aembit.PolicyExternalDTO{
+		EntityDTO:          aembit.EntityDTO{ExternalID: "source-policy", Name: "policy", IsActive: true},
+		ClientWorkload:     aembit.EntityDTO{ExternalID: "source-cw"},
+		ServerWorkload:     aembit.EntityDTO{ExternalID: "source-sw"},
+		CredentialProvider: aembit.EntityDTO{ExternalID: "source-cp"},
+		TrustProviders:     []aembit.EntityDTO{{ExternalID: "source-tp"}},
+		AccessConditions:   []aembit.EntityDTO{{ExternalID: "source-ac"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if policy.ClientWorkload != "target-cw" || policy.ServerWorkload != "target-sw" || policy.CredentialProvider != "target-cp" {
+		t.Errorf("workloads and credential provider were not remapped: %+v", policy)
+	}
+	if want := []string{"target-tp"}; !reflect.DeepEqual(policy.TrustProviders, want) {
+		t.Errorf("trust providers = %v, want %v", policy.TrustProviders, want)
+	}
+	if want := []string{"target-ac"}; !reflect.DeepEqual(policy.AccessConditions, want) {
+		t.Errorf("access conditions = %v, want %v", policy.AccessConditions, want)
+	}
+	if len(policy.ExternalID) > 0 {
+		t.Errorf("source external id must not be copied, got %s", policy.ExternalID)
+	}
+}
+
+func TestPromoteRemapPolicy_MissingReference(t *testing.T) {
+	p := &promoter{ids: map[string]string{"source-cw": "target-cw"}}
+
+	_, _, err := p.remapPolicy(aembit.PolicyExternalDTO{
+		ClientWorkload: aembit.EntityDTO{ExternalID: "source-cw"},
+		ServerWorkload: aembit.EntityDTO{ExternalID: "source-sw"},
+	})
+	if err == nil {
+		t.Fatal("expected an error for an unpromoted server workload")
+	}
+}
+
+func TestPromoteCredentialProviderDetail(t *testing.T) {
+	p := &promoter{
+		client: &provider.CloudClient{CloudClient: &aembit.CloudClient{Tenant: "target", StackDomain: "useast2.aembit.io"}},
+		source: Snapshot{Roles: []aembit.EntityDTO{{ExternalID: "source-role", Name: "Terraform"}, {ExternalID: "source-auditor", Name: "Auditor"}}},
+		target: Snapshot{Roles: []aembit.EntityDTO{{ExternalID: "target-role", Name: "Terraform"}}},
+	}
+
+	if _, err := p.credentialProviderDetail(aembit.CredentialProviderDTO{Type: "apikey"}, "", false); err == nil {
+		t.Error("expected api key credential provider to be skipped without a secret")
+	}
+
+	detail, err := p.credentialProviderDetail(aembit.CredentialProviderDTO{Type: "apikey"}, "secret", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var apiKey aembit.CredentialAPIKeyDTO
+	if err = json.Unmarshal([]byte(detail), &apiKey); err != nil || apiKey.APIKey != "secret" {
+		t.Errorf("api key was not injected: %s", detail)
+	}
+
+	source, _ := json.Marshal(aembit.CredentialAembitTokenDTO{Audience: "source.api.useast2.aembit.io", RoleID: "source-role"})
+	detail, err = p.credentialProviderDetail(aembit.CredentialProviderDTO{Type: "aembit-access-token", ProviderDetail: string(source)}, "", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var aembitToken aembit.CredentialAembitTokenDTO
+	if err = json.Unmarshal([]byte(detail), &aembitToken); err != nil || aembitToken.Audience != "target.api.useast2.aembit.io" {
+		t.Errorf("audience was not rewritten for the target tenant: %s", detail)
+	}
+	if aembitToken.RoleID != "target-role" {
+		t.Errorf("role was not remapped to the target role of the same name: %s", detail)
+	}
+
+	for roleID, contains := range map[string]string{"source-auditor": `"Auditor" does not exist`, "deleted-role": "not in the source snapshot"} {
+		source, _ = json.Marshal(aembit.CredentialAembitTokenDTO{RoleID: roleID})
+		_, err = p.credentialProviderDetail(aembit.CredentialProviderDTO{Type: "aembit-access-token", ProviderDetail: string(source)}, "", false)
+		if err == nil || !strings.Contains(err.Error(), contains) {
+			t.Errorf("role %s: error = %v, want it to contain %q", roleID, err, contains)
+		}
+	}
+}
+
+func TestPromoteSecretsWriteOnly(t *testing.T) {
+	source := Snapshot{
+		CredentialProviders: []aembit.CredentialProviderDTO{
+			{EntityDTO: aembit.EntityDTO{ExternalID: "source-cp", Name: "api"}, Type: "apikey", ProviderDetail: `{}`},
+		},
+		Integrations: []aembit.IntegrationDTO{
+			{EntityDTO: aembit.EntityDTO{ExternalID: "source-wiz", Name: "wiz"}, IntegrationJSON: aembit.IntegrationJSONDTO{ClientID: "client"}},
+		},
+	}
+	p := &promoter{
+		target: Snapshot{
+			CredentialProviders: []aembit.CredentialProviderDTO{
+				{EntityDTO: aembit.EntityDTO{ExternalID: "target-cp", Name: "api"}, Type: "apikey", ProviderDetail: `{"apiKey":""}`},
+			},
+			Integrations: []aembit.IntegrationDTO{
+				{EntityDTO: aembit.EntityDTO{ExternalID: "target-wiz", Name: "wiz"}, IntegrationJSON: aembit.IntegrationJSONDTO{ClientID: "client"}},
+			},
+		},
+		options: PromoteOptions{
+			DryRun: true,
+			Secrets: PromoteSecrets{
+				CredentialProviders: map[string]string{"api": "secret"},
+				Integrations:        map[string]string{"wiz": "secret"},
+			},
+		},
+		ids: make(map[string]string),
+	}
+
+	if err := p.promoteCredentialProviders(source); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := p.promoteIntegrations(source); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, action := range p.actions {
+		if action.Action != actionUnchanged {
+			t.Errorf("%s %s: action = %s (%s), want the supplied secret to be ignored", action.Type, action.Name, action.Action, action.Reason)
+		}
+	}
+
+	// A change to any other field is still an update.
+	source.Integrations[0].IntegrationJSON.ClientID = "other-client"
+	p.actions = nil
+	if err := p.promoteIntegrations(source); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(p.actions) != 1 || p.actions[0].Action != actionUpdate || p.actions[0].Reason != "integrationJSON" {
+		t.Errorf("expected the integration to be updated, got %+v", p.actions)
+	}
+}
+
+func TestFindByName(t *testing.T) {
+	items := []aembit.IntegrationDTO{
+		{EntityDTO: aembit.EntityDTO{ExternalID: "1", Name: "wiz"}},
+		{EntityDTO: aembit.EntityDTO{ExternalID: "2", Name: "dup"}},
+		{EntityDTO: aembit.EntityDTO{ExternalID: "3", Name: "dup"}},
+	}
+	entity := func(e aembit.IntegrationDTO) aembit.EntityDTO { return e.EntityDTO }
+
+	if found, ok, err := findByName(items, "wiz", entity); err != nil || !ok || found.ExternalID != "1" {
+		t.Errorf("expected to find wiz, got %v %v %v", found, ok, err)
+	}
+	if _, ok, err := findByName(items, "missing", entity); err != nil || ok {
+		t.Errorf("expected missing to not be found, got %v %v", ok, err)
+	}
+	if _, _, err := findByName(items, "dup", entity); err == nil {
+		t.Error("expected an error for an ambiguous name")
+	}
+}
+
+func TestChangedFields_OwnedFieldsOnly(t *testing.T) {
+	existing := aembit.AgentControllerDTO{
+		EntityDTO:              aembit.EntityDTO{ExternalID: "target", Name: "controller", IsActive: true},
+		Version:                "1.2.3",
+		IsHealthy:              true,
+		LastReportedHealthTime: "2024-01-01T00:00:00Z",
+	}
+	desired := aembit.AgentControllerDTO{
+		EntityDTO: aembit.EntityDTO{ExternalID: "source", Name: "controller", IsActive: true, Tags: []aembit.TagDTO{}},
+	}
+
+	if fields := changedFields(existing, desired); len(fields) != 0 {
+		t.Errorf("expected server managed and empty fields to be ignored, got %v", fields)
+	}
+
+	desired.Description = "changed"
+	if fields := changedFields(existing, desired); !reflect.DeepEqual(fields, []string{"description"}) {
+		t.Errorf("expected the description to be changed, got %v", fields)
+	}
+}
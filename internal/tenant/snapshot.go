@@ -0,0 +1,211 @@
+package tenant
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+
+	"aembit.io/aembit"
+	"terraform-provider-aembit/internal/provider"
+)
+
+// Snapshot is a normalized point-in-time copy of every entity in an Aembit Tenant.
+type Snapshot struct {
+	Tenant              string                             `json:"tenant"`
+	StackDomain         string                             `json:"stackDomain"`
+	AgentControllers    []aembit.AgentControllerDTO        `json:"agentControllers"`
+	TrustProviders      []aembit.TrustProviderDTO          `json:"trustProviders"`
+	ClientWorkloads     []aembit.ClientWorkloadExternalDTO `json:"clientWorkloads"`
+	ServerWorkloads     []aembit.ServerWorkloadExternalDTO `json:"serverWorkloads"`
+	CredentialProviders []aembit.CredentialProviderDTO     `json:"credentialProviders"`
+	Integrations        []aembit.IntegrationDTO            `json:"integrations"`
+	AccessConditions    []aembit.AccessConditionDTO        `json:"accessConditions"`
+	AccessPolicies      []aembit.PolicyExternalDTO         `json:"accessPolicies"`
+	Roles               []aembit.EntityDTO                 `json:"roles"`
+}
+
+// TakeSnapshot reads all entities from the Aembit Tenant and returns them as a normalized Snapshot.
+func TakeSnapshot(client *provider.CloudClient) (Snapshot, error) {
+	var snapshot Snapshot
+	var err error
+
+	snapshot.Tenant = client.Tenant
+	snapshot.StackDomain = client.StackDomain
+	if snapshot.AgentControllers, err = client.GetAgentControllers(nil); err != nil {
+		return snapshot, err
+	}
+	if snapshot.TrustProviders, err = client.GetTrustProviders(nil); err != nil {
+		return snapshot, err
+	}
+	if snapshot.ClientWorkloads, err = client.GetClientWorkloads(nil); err != nil {
+		return snapshot, err
+	}
+	if snapshot.ServerWorkloads, err = client.GetServerWorkloads(nil); err != nil {
+		return snapshot, err
+	}
+	if snapshot.CredentialProviders, err = client.GetCredentialProviders(nil); err != nil {
+		return snapshot, err
+	}
+	if snapshot.Integrations, err = client.GetIntegrations(nil); err != nil {
+		return snapshot, err
+	}
+	if snapshot.AccessConditions, err = client.GetAccessConditions(nil); err != nil {
+		return snapshot, err
+	}
+	if snapshot.AccessPolicies, err = client.GetAccessPolicies(nil); err != nil {
+		return snapshot, err
+	}
+	if snapshot.Roles, err = client.GetRoles(nil); err != nil {
+		return snapshot, err
+	}
+
+	snapshot.Normalize()
+	return snapshot, nil
+}
+
+// ReadSnapshot loads a Snapshot previously written by WriteSnapshot.
+func ReadSnapshot(fileName string) (Snapshot, error) {
+	var snapshot Snapshot
+
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return snapshot, err
+	}
+	if err = json.Unmarshal(data, &snapshot); err != nil {
+		return snapshot, err
+	}
+
+	snapshot.Normalize()
+	return snapshot, nil
+}
+
+// WriteSnapshot writes the Snapshot as indented JSON to the named file, or to stdout when fileName is "-".
+func WriteSnapshot(snapshot Snapshot, fileName string) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	if fileName == "-" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(fileName, data, 0600)
+}
+
+// Normalize sorts entities by External ID, along with their tags, match rules, identities and other
+// unordered collections, so that two snapshots of an unchanged Tenant are byte-for-byte identical.
+func (s *Snapshot) Normalize() {
+	for i := range s.AgentControllers {
+		sortTags(s.AgentControllers[i].Tags)
+	}
+	sort.SliceStable(s.AgentControllers, func(i, j int) bool {
+		return s.AgentControllers[i].ExternalID < s.AgentControllers[j].ExternalID
+	})
+
+	for i := range s.TrustProviders {
+		trust := &s.TrustProviders[i]
+		sortTags(trust.Tags)
+		sort.Strings(trust.AgentControllerIDs)
+		sort.SliceStable(trust.MatchRules, func(i, j int) bool {
+			if trust.MatchRules[i].Attribute != trust.MatchRules[j].Attribute {
+				return trust.MatchRules[i].Attribute < trust.MatchRules[j].Attribute
+			}
+			return trust.MatchRules[i].Value < trust.MatchRules[j].Value
+		})
+	}
+	sort.SliceStable(s.TrustProviders, func(i, j int) bool {
+		return s.TrustProviders[i].ExternalID < s.TrustProviders[j].ExternalID
+	})
+
+	for i := range s.ClientWorkloads {
+		workload := &s.ClientWorkloads[i]
+		sortTags(workload.Tags)
+		sort.SliceStable(workload.Identities, func(i, j int) bool {
+			if workload.Identities[i].Type != workload.Identities[j].Type {
+				return workload.Identities[i].Type < workload.Identities[j].Type
+			}
+			return workload.Identities[i].Value < workload.Identities[j].Value
+		})
+	}
+	sort.SliceStable(s.ClientWorkloads, func(i, j int) bool {
+		return s.ClientWorkloads[i].ExternalID < s.ClientWorkloads[j].ExternalID
+	})
+
+	for i := range s.ServerWorkloads {
+		workload := &s.ServerWorkloads[i]
+		sortTags(workload.Tags)
+		sort.SliceStable(workload.ServiceEndpoint.HTTPHeaders, func(i, j int) bool {
+			return workload.ServiceEndpoint.HTTPHeaders[i].Key < workload.ServiceEndpoint.HTTPHeaders[j].Key
+		})
+	}
+	sort.SliceStable(s.ServerWorkloads, func(i, j int) bool {
+		return s.ServerWorkloads[i].ExternalID < s.ServerWorkloads[j].ExternalID
+	})
+
+	for i := range s.CredentialProviders {
+		sortTags(s.CredentialProviders[i].Tags)
+		s.CredentialProviders[i].ProviderDetail = normalizeJSON(s.CredentialProviders[i].ProviderDetail)
+	}
+	sort.SliceStable(s.CredentialProviders, func(i, j int) bool {
+		return s.CredentialProviders[i].ExternalID < s.CredentialProviders[j].ExternalID
+	})
+
+	for i := range s.Integrations {
+		sortTags(s.Integrations[i].Tags)
+	}
+	sort.SliceStable(s.Integrations, func(i, j int) bool {
+		return s.Integrations[i].ExternalID < s.Integrations[j].ExternalID
+	})
+
+	for i := range s.AccessConditions {
+		sortTags(s.AccessConditions[i].Tags)
+	}
+	sort.SliceStable(s.AccessConditions, func(i, j int) bool {
+		return s.AccessConditions[i].ExternalID < s.AccessConditions[j].ExternalID
+	})
+
+	for i := range s.AccessPolicies {
+		policy := &s.AccessPolicies[i]
+		sortTags(policy.Tags)
+		sort.SliceStable(policy.TrustProviders, func(i, j int) bool {
+			return policy.TrustProviders[i].ExternalID < policy.TrustProviders[j].ExternalID
+		})
+		sort.SliceStable(policy.AccessConditions, func(i, j int) bool {
+			return policy.AccessConditions[i].ExternalID < policy.AccessConditions[j].ExternalID
+		})
+	}
+	sort.SliceStable(s.AccessPolicies, func(i, j int) bool {
+		return s.AccessPolicies[i].ExternalID < s.AccessPolicies[j].ExternalID
+	})
+
+	for i := range s.Roles {
+		sortTags(s.Roles[i].Tags)
+	}
+	sort.SliceStable(s.Roles, func(i, j int) bool {
+		return s.Roles[i].ExternalID < s.Roles[j].ExternalID
+	})
+}
+
+func sortTags(tags []aembit.TagDTO) {
+	sort.SliceStable(tags, func(i, j int) bool {
+		if tags[i].Key != tags[j].Key {
+			return tags[i].Key < tags[j].Key
+		}
+		return tags[i].Value < tags[j].Value
+	})
+}
+
+// normalizeJSON re-encodes a JSON document with sorted keys, returning the input unchanged if it is not valid JSON.
+func normalizeJSON(value string) string {
+	var document interface{}
+	if err := json.Unmarshal([]byte(value), &document); err != nil {
+		return value
+	}
+	normalized, err := json.Marshal(document)
+	if err != nil {
+		return value
+	}
+	return string(normalized)
+}
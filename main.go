@@ -7,8 +7,11 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
+	"time"
 
 	"terraform-provider-aembit/internal/provider"
+	"terraform-provider-aembit/internal/tenant"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
@@ -35,6 +38,15 @@ var (
 func main() {
 	var debug bool
 
+	// Tenant management subcommands (e.g. snapshot, diff) run outside of Terraform.
+	if len(os.Args) > 1 && tenant.IsCommand(os.Args[1]) {
+		exitCode, err := tenant.RunCommand(os.Args[1], os.Args[2:], version)
+		if err != nil {
+			log.Print(err.Error())
+		}
+		os.Exit(exitCode)
+	}
+
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
@@ -45,6 +57,13 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
 
+	// Terraform is done with the provider, so report the buffered change events (if enabled)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	if flushErr := provider.FlushEvents(ctx); flushErr != nil {
+		log.Printf("Failed to report Aembit events: %s", flushErr.Error())
+	}
+	cancel()
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}
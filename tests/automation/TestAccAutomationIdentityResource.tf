@@ -0,0 +1,12 @@
+provider "aembit" {
+}
+
+resource "aembit_automation_identity" "github" {
+	name = "TF Acceptance Automation Identity"
+	role_id = "cca45e0a-c1c6-4b24-a895-52b547861a28"
+	lifetime = 1800
+	github_action = {
+		repository = "aembit/terraform-provider-aembit"
+		ref = "refs/heads/main"
+	}
+}
@@ -0,0 +1,26 @@
+provider "aembit" {
+}
+
+resource "aembit_server_workload" "test" {
+	name = "Unit Test Lookup"
+	description = "Description"
+	is_active = true
+	service_endpoint = {
+		host = "lookup.testhost.com"
+		port = 443
+		tls = true
+		app_protocol = "HTTP"
+		transport_protocol = "TCP"
+		requested_port = 443
+		requested_tls = true
+		tls_verification = "full"
+	}
+}
+
+data "aembit_server_workload" "by_name" {
+	name = aembit_server_workload.test.name
+}
+
+data "aembit_server_workload" "by_id" {
+	id = aembit_server_workload.test.id
+}
@@ -0,0 +1,11 @@
+provider "aembit" {
+}
+
+resource "aembit_trust_provider" "github" {
+	name = "TF Acceptance GitHub Action Repositories"
+	is_active = true
+	github_action = {
+		actor = "actor"
+		repositories = ["owner/repository1", "owner/repository2", "owner/repository3"]
+	}
+}
@@ -0,0 +1,13 @@
+provider "aembit" {
+}
+
+resource "aembit_trust_provider" "gitlab" {
+	name = "TF Acceptance GitLab Job"
+	is_active = true
+	gitlab_job = {
+		namespace_path = "namespace_path"
+		project_path = "namespace_path/project_path"
+		ref_path = "refs/heads/main"
+		user_login = "user_login"
+	}
+}
\ No newline at end of file
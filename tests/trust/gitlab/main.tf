@@ -0,0 +1,7 @@
+terraform {
+  required_providers {
+    aembit = {
+      source  = "aembit/aembit"
+    }
+  }
+}
@@ -0,0 +1,24 @@
+provider "aembit" {
+}
+
+resource "aembit_trust_provider" "kubernetes_jwks" {
+	name = "TF Acceptance Kubernetes JWKS"
+	is_active = true
+	kubernetes_service_account = {
+		issuer = "https://kubernetes.default.svc.cluster.local"
+		namespace = "namespace"
+		service_account_name = "service_account_name"
+		jwks = jsonencode({
+			keys = [
+				{
+					use = "sig"
+					kty = "RSA"
+					kid = "acceptance"
+					alg = "RS256"
+					n = "w9WFbqOw4LIji13JYLkDBwMsEMzmFeOiFEIku-JrXhuuQ-tof6AS5KFn1_ipCt_5DHNA7QRlyhZ386xUmD2bYB94ZUivHqme5yJvfpnte0yzxC29I3MF9jFgWpt7nnpkno54LKXnp8PW100eooMyGY-KSmmVbQ5yj0kuYMg2Z6n07TtQpg01VCBOYL8PkU8053tLQ7fC-V5tKybXgt0Ea3zoan67GQd_BXUcP9-_Xd7Wuk6vTXgQ6JelIMi4JPMUeJtsH5-fKbVn3ooJ1N8sMFt142-AwHgTfKBTmhJECUiaIu1_YnCeGrOeMpOrLy2MCngJ0TIxoyrV0wGNJbi98Q"
+					e = "AQAB"
+				},
+			]
+		})
+	}
+}
\ No newline at end of file
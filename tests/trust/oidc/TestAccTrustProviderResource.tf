@@ -0,0 +1,16 @@
+provider "aembit" {
+}
+
+resource "aembit_trust_provider" "oidc" {
+	name = "TF Acceptance OIDC ID Token"
+	is_active = true
+	oidc_id_token = {
+		issuer = "https://issuer.example.com"
+		jwks_url = "https://issuer.example.com/.well-known/jwks.json"
+		audience = "audience"
+		subject = "subject"
+		claims = {
+			group = "group"
+		}
+	}
+}
\ No newline at end of file